@@ -1,6 +1,11 @@
 package main
 
-import "github.com/alexey-shedrin/avito-test-task/internal/app"
+import (
+	// Часовые пояса городов проверяются через time.LoadLocation, а в alpine нет tzdata.
+	_ "time/tzdata"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/app"
+)
 
 func main() {
 	app.Run()
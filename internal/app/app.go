@@ -1,15 +1,17 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/alexey-shedrin/avito-test-task/internal/config"
 	"github.com/alexey-shedrin/avito-test-task/internal/database"
-	openapi "github.com/alexey-shedrin/avito-test-task/internal/gen"
 	pvzv1 "github.com/alexey-shedrin/avito-test-task/internal/grpc/pvz/v1"
 	"github.com/alexey-shedrin/avito-test-task/internal/handler"
+	"github.com/alexey-shedrin/avito-test-task/internal/job"
 	"github.com/alexey-shedrin/avito-test-task/internal/metrics"
+	"github.com/alexey-shedrin/avito-test-task/internal/middleware"
 	"github.com/alexey-shedrin/avito-test-task/internal/repository"
 	"github.com/alexey-shedrin/avito-test-task/internal/service"
 	"github.com/gin-gonic/gin"
@@ -28,26 +30,66 @@ func Run() {
 	userRepo := repository.NewUserRepository(db)
 	pvzRepo := repository.NewPVZRepository(db)
 	receptionRepo := repository.NewReceptionRepository(db)
+	reportRepo := repository.NewReportRepository(db)
+	productTypeRepo := repository.NewProductTypeRepository(db)
+	cityRepo := repository.NewCityRepository(db)
+	shipmentRepo := repository.NewShipmentRepository(db)
+	idempotencyRepo := repository.NewIdempotencyRepository(db)
 
 	userService := service.NewUserService(userRepo)
 	pvzService := service.NewPVZService(pvzRepo)
-	receptionService := service.NewReceptionService(receptionRepo, db)
+	receptionService := service.NewReceptionService(receptionRepo).WithReopenWindow(cfg.Reception.ReopenWindow)
+	reportService := service.NewReportService(reportRepo)
+	productTypeService := service.NewProductTypeService(productTypeRepo)
+	cityService := service.NewCityService(cityRepo)
+	shipmentService := service.NewShipmentService(shipmentRepo)
 
-	hndlr := handler.New(userService, pvzService, receptionService)
+	hndlr := handler.New(handler.Services{
+		User:        userService,
+		Pvz:         pvzService,
+		Reception:   receptionService,
+		Report:      reportService,
+		ProductType: productTypeService,
+		City:        cityService,
+		Shipment:    shipmentService,
+	})
 	r := gin.Default()
 
-	openapi.RegisterHandlers(r, hndlr)
+	if err = handler.Register(r, hndlr, middleware.Idempotency(idempotencyRepo, cfg.Idempotency.TTL)); err != nil {
+		log.Fatalf("failed to register handlers: %v", err)
+	}
 
 	r.Use(metrics.GetMetricsMiddleware())
 
 	serverAddr := fmt.Sprintf("%s:%s", cfg.HttpServer.Host, cfg.HttpServer.Port)
 	go func() {
-		pvzv1.Start(cfg.GrpcServer.Port)
+		pvzv1.Start(cfg.GrpcServer.Port, pvzService)
 	}()
 
 	go func() {
 		metrics.StartMetricsServer(cfg.PrometheusServer.Port)
 	}()
 
+	dailyReportJob, err := job.NewDailyReport(reportService, cfg.DailyReport)
+	if err != nil {
+		log.Fatalf("failed to create daily report job: %v", err)
+	}
+
+	go dailyReportJob.Run(context.Background())
+
+	receptionSweeper, err := job.NewReceptionSweeper(receptionService, cfg.ReceptionSweeper)
+	if err != nil {
+		log.Fatalf("failed to create reception sweeper: %v", err)
+	}
+
+	go receptionSweeper.Run(context.Background())
+
+	idempotencyCleaner, err := job.NewIdempotencyCleaner(idempotencyRepo, cfg.Idempotency)
+	if err != nil {
+		log.Fatalf("failed to create idempotency cleaner: %v", err)
+	}
+
+	go idempotencyCleaner.Run(context.Background())
+
 	r.Run(serverAddr)
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/ilyakaznacheev/cleanenv"
 	"log"
 	"os"
+	"time"
 )
 
 type Config struct {
@@ -11,6 +12,10 @@ type Config struct {
 	Database         Database         `yaml:"database"`
 	GrpcServer       GrpcServer       `yaml:"grpc_server"`
 	PrometheusServer PrometheusServer `yaml:"prometheus_server"`
+	DailyReport      DailyReport      `yaml:"daily_report"`
+	ReceptionSweeper ReceptionSweeper `yaml:"reception_sweeper"`
+	Idempotency      Idempotency      `yaml:"idempotency"`
+	Reception        Reception        `yaml:"reception"`
 }
 
 type HttpServer struct {
@@ -34,6 +39,24 @@ type PrometheusServer struct {
 	Port string `yaml:"port"`
 }
 
+type DailyReport struct {
+	Time         string `yaml:"time" env-default:"01:00"`
+	BackfillDays int    `yaml:"backfill_days" env-default:"30"`
+}
+
+type ReceptionSweeper struct {
+	Interval time.Duration `yaml:"interval" env-default:"1m"`
+}
+
+type Idempotency struct {
+	TTL             time.Duration `yaml:"ttl" env-default:"24h"`
+	CleanupInterval time.Duration `yaml:"cleanup_interval" env-default:"1h"`
+}
+
+type Reception struct {
+	ReopenWindow time.Duration `yaml:"reopen_window" env-default:"24h"`
+}
+
 func New() *Config {
 	path := os.Getenv("CONFIG_PATH")
 	if path == "" {
@@ -10,7 +10,7 @@ import (
 )
 
 func New(cfg *config.Config) (*sql.DB, error) {
-	conStr := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable", cfg.Database.User, cfg.Database.Password, cfg.Database.Host, cfg.Database.Port, cfg.Database.Name)
+	conStr := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable&timezone=UTC", cfg.Database.User, cfg.Database.Password, cfg.Database.Host, cfg.Database.Port, cfg.Database.Name)
 
 	db, err := sql.Open("postgres", conStr)
 	if err != nil {
@@ -38,3 +38,10 @@ func IsUniqueViolation(err error) bool {
 	}
 	return false
 }
+
+func IsForeignKeyViolation(err error) bool {
+	if pqErr, ok := err.(*pq.Error); ok {
+		return pqErr.Code == "23503"
+	}
+	return false
+}
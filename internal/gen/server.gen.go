@@ -10,12 +10,14 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
 	"time"
 
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime"
@@ -27,30 +29,25 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
-// Defines values for PVZCity.
+// Defines values for PVZStatus.
 const (
-	Казань         PVZCity = "Казань"
-	Москва         PVZCity = "Москва"
-	СанктПетербург PVZCity = "Санкт-Петербург"
+	Active    PVZStatus = "active"
+	Closed    PVZStatus = "closed"
+	Suspended PVZStatus = "suspended"
 )
 
-// Defines values for ProductType.
+// Defines values for ProductStatus.
 const (
-	ProductTypeОбувь       ProductType = "обувь"
-	ProductTypeОдежда      ProductType = "одежда"
-	ProductTypeЭлектроника ProductType = "электроника"
+	ProductStatusAccepted         ProductStatus = "accepted"
+	ProductStatusIssued           ProductStatus = "issued"
+	ProductStatusReturnedToSender ProductStatus = "returned_to_sender"
+	ProductStatusStored           ProductStatus = "stored"
 )
 
-// Defines values for ReceptionStatus.
+// Defines values for StorageLimitMode.
 const (
-	Close      ReceptionStatus = "close"
-	InProgress ReceptionStatus = "in_progress"
-)
-
-// Defines values for UserRole.
-const (
-	UserRoleEmployee  UserRole = "employee"
-	UserRoleModerator UserRole = "moderator"
+	Reject StorageLimitMode = "reject"
+	Warn   StorageLimitMode = "warn"
 )
 
 // Defines values for PostDummyLoginJSONBodyRole.
@@ -59,68 +56,187 @@ const (
 	PostDummyLoginJSONBodyRoleModerator PostDummyLoginJSONBodyRole = "moderator"
 )
 
-// Defines values for PostProductsJSONBodyType.
+// Defines values for PostProductsBatchJSONBodyMode.
+const (
+	Atomic  PostProductsBatchJSONBodyMode = "atomic"
+	Partial PostProductsBatchJSONBodyMode = "partial"
+)
+
+// Defines values for GetPvzParamsSort.
+const (
+	GetPvzParamsSortCity             GetPvzParamsSort = "city"
+	GetPvzParamsSortLastReception    GetPvzParamsSort = "lastReception"
+	GetPvzParamsSortProductCount     GetPvzParamsSort = "productCount"
+	GetPvzParamsSortRegistrationDate GetPvzParamsSort = "registrationDate"
+)
+
+// Defines values for GetPvzParamsOrder.
+const (
+	Asc  GetPvzParamsOrder = "asc"
+	Desc GetPvzParamsOrder = "desc"
+)
+
+// Defines values for GetPvzParamsView.
 const (
-	PostProductsJSONBodyTypeОбувь       PostProductsJSONBodyType = "обувь"
-	PostProductsJSONBodyTypeОдежда      PostProductsJSONBodyType = "одежда"
-	PostProductsJSONBodyTypeЭлектроника PostProductsJSONBodyType = "электроника"
+	Full           GetPvzParamsView = "full"
+	Summary        GetPvzParamsView = "summary"
+	WithReceptions GetPvzParamsView = "with_receptions"
+)
+
+// Defines values for GetPvzPvzIdProductsParamsStatus.
+const (
+	GetPvzPvzIdProductsParamsStatusAccepted GetPvzPvzIdProductsParamsStatus = "accepted"
+	GetPvzPvzIdProductsParamsStatusStored   GetPvzPvzIdProductsParamsStatus = "stored"
 )
 
 // Defines values for PostRegisterJSONBodyRole.
 const (
-	Employee  PostRegisterJSONBodyRole = "employee"
-	Moderator PostRegisterJSONBodyRole = "moderator"
+	PostRegisterJSONBodyRoleEmployee  PostRegisterJSONBodyRole = "employee"
+	PostRegisterJSONBodyRoleModerator PostRegisterJSONBodyRole = "moderator"
 )
 
-// Error defines model for Error.
+// Barcode EAN-13 или внутренний код посылки вида PVZ0123456789
+type Barcode = string
+
+// City defines model for City.
+type City struct {
+	// Active В неактивном городе нельзя создать ПВЗ
+	Active    *bool      `json:"active,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	Name      string     `json:"name"`
+	Region    string     `json:"region"`
+
+	// Timezone Часовой пояс IANA, например Europe/Moscow
+	Timezone string `json:"timezone"`
+}
+
+// DailyReport defines model for DailyReport.
+type DailyReport = response.DailyReport
+
+// Error Описание ошибки в формате RFC 7807 (application/problem+json)
 type Error struct {
-	Message string `json:"message"`
+	// Code Машиночитаемый код ошибки, например reception_not_opened
+	Code string `json:"code"`
+
+	// Detail Описание ошибки для человека
+	Detail string `json:"detail"`
+
+	// Details Дополнительные сведения об ошибке
+	Details *map[string]interface{} `json:"details,omitempty"`
+
+	// Errors Нарушения валидации по полям тела запроса
+	Errors *[]FieldError `json:"errors,omitempty"`
+
+	// Instance Путь запроса, на который получена ошибка
+	Instance *string `json:"instance,omitempty"`
+
+	// Status HTTP-статус ответа
+	Status int `json:"status"`
+
+	// Title Текст HTTP-статуса
+	Title string `json:"title"`
+
+	// Type URI типа ошибки, всегда about:blank
+	Type string `json:"type"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field Путь к полю в теле запроса через точку или имя параметра запроса
+	Field string `json:"field"`
+
+	// Param Параметр правила, например допустимые значения или граница
+	Param *string `json:"param,omitempty"`
+
+	// Rule Нарушенное правило схемы запроса, например required, enum, maximum, pattern или type
+	Rule string `json:"rule"`
 }
 
 // PVZ defines model for PVZ.
-type PVZ struct {
-	City             PVZCity             `json:"city"`
-	Id               *openapi_types.UUID `json:"id,omitempty"`
-	RegistrationDate *time.Time          `json:"registrationDate,omitempty"`
+type PVZ = response.Pvz
+
+// PVZSettings defines model for PVZSettings.
+type PVZSettings struct {
+	MaxOpenMinutes          *int   `json:"maxOpenMinutes"`
+	MaxProductsPerReception *int   `json:"maxProductsPerReception"`
+	MaxStorageVolumeCm3     *int64 `json:"maxStorageVolumeCm3"`
+
+	// StorageLimitMode Что делать с товаром, превышающим лимит объема ПВЗ: reject отклоняет товар,
+	// warn принимает его с пометкой storageLimitExceeded
+	StorageLimitMode *StorageLimitMode `json:"storageLimitMode,omitempty"`
 }
 
-// PVZCity defines model for PVZ.City.
-type PVZCity string
+// PVZStatus В приостановленном или закрытом ПВЗ нельзя открыть приемку
+type PVZStatus string
 
-// Product defines model for Product.
-type Product struct {
-	DateTime    *time.Time          `json:"dateTime,omitempty"`
-	Id          *openapi_types.UUID `json:"id,omitempty"`
-	ReceptionId openapi_types.UUID  `json:"receptionId"`
-	Type        ProductType         `json:"type"`
+// PVZUpdate Изменяются только переданные поля. Координаты передаются парой
+type PVZUpdate struct {
+	Address      *string  `json:"address,omitempty"`
+	Latitude     *float64 `json:"latitude,omitempty"`
+	Longitude    *float64 `json:"longitude,omitempty"`
+	OpeningHours *string  `json:"openingHours,omitempty"`
+	Phone        *string  `json:"phone,omitempty"`
+
+	// Status В приостановленном или закрытом ПВЗ нельзя открыть приемку
+	Status *PVZStatus `json:"status,omitempty"`
 }
 
-// ProductType defines model for Product.Type.
-type ProductType string
+// Product defines model for Product.
+type Product = response.Product
+
+// ProductBatch defines model for ProductBatch.
+type ProductBatch = response.ProductBatch
+
+// ProductDimensions Габариты товара в сантиметрах
+type ProductDimensions = response.ProductDimensions
+
+// ProductStatus Жизненный цикл товара: accepted → stored → issued | returned_to_sender.
+// На хранение передаются только товары закрытых приемок
+type ProductStatus string
+
+// ProductType defines model for ProductType.
+type ProductType = response.ProductType
 
 // Reception defines model for Reception.
-type Reception struct {
-	DateTime time.Time           `json:"dateTime"`
-	Id       *openapi_types.UUID `json:"id,omitempty"`
-	PvzId    openapi_types.UUID  `json:"pvzId"`
-	Status   ReceptionStatus     `json:"status"`
-}
+type Reception = response.Reception
+
+// Shipment Отгрузка невостребованных товаров отправителю
+type Shipment = response.Shipment
 
-// ReceptionStatus defines model for Reception.Status.
-type ReceptionStatus string
+// Stats defines model for Stats.
+type Stats = response.Stats
+
+// StorageLimitMode Что делать с товаром, превышающим лимит объема ПВЗ: reject отклоняет товар,
+// warn принимает его с пометкой storageLimitExceeded
+type StorageLimitMode string
 
 // Token defines model for Token.
-type Token = string
+type Token struct {
+	Token string `json:"token"`
+}
 
 // User defines model for User.
-type User struct {
-	Email openapi_types.Email `json:"email"`
-	Id    *openapi_types.UUID `json:"id,omitempty"`
-	Role  UserRole            `json:"role"`
-}
+type User = response.User
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// Forbidden Описание ошибки в формате RFC 7807 (application/problem+json)
+type Forbidden = Error
+
+// InternalError Описание ошибки в формате RFC 7807 (application/problem+json)
+type InternalError = Error
 
-// UserRole defines model for User.Role.
-type UserRole string
+// PreconditionFailed Описание ошибки в формате RFC 7807 (application/problem+json)
+type PreconditionFailed = Error
+
+// Unauthorized Описание ошибки в формате RFC 7807 (application/problem+json)
+type Unauthorized = Error
+
+// GetCitiesParams defines parameters for GetCities.
+type GetCitiesParams struct {
+	IncludeInactive *bool `form:"includeInactive,omitempty" json:"includeInactive,omitempty"`
+}
 
 // PostDummyLoginJSONBody defines parameters for PostDummyLogin.
 type PostDummyLoginJSONBody struct {
@@ -130,20 +246,80 @@ type PostDummyLoginJSONBody struct {
 // PostDummyLoginJSONBodyRole defines parameters for PostDummyLogin.
 type PostDummyLoginJSONBodyRole string
 
+// GetExportReceptionsCsvParams defines parameters for GetExportReceptionsCsv.
+type GetExportReceptionsCsvParams struct {
+	// StartDate Начальная дата диапазона
+	StartDate *time.Time `form:"startDate,omitempty" json:"startDate,omitempty"`
+
+	// EndDate Конечная дата диапазона
+	EndDate *time.Time `form:"endDate,omitempty" json:"endDate,omitempty"`
+
+	// City Город ПВЗ
+	City *string `form:"city,omitempty" json:"city,omitempty"`
+}
+
+// GetExportReceptionsXlsxParams defines parameters for GetExportReceptionsXlsx.
+type GetExportReceptionsXlsxParams struct {
+	// StartDate Начальная дата диапазона
+	StartDate *time.Time `form:"startDate,omitempty" json:"startDate,omitempty"`
+
+	// EndDate Конечная дата диапазона
+	EndDate *time.Time `form:"endDate,omitempty" json:"endDate,omitempty"`
+
+	// City Город ПВЗ
+	City *string `form:"city,omitempty" json:"city,omitempty"`
+}
+
 // PostLoginJSONBody defines parameters for PostLogin.
 type PostLoginJSONBody struct {
 	Email    openapi_types.Email `json:"email"`
 	Password string              `json:"password"`
 }
 
+// GetProductTypesParams defines parameters for GetProductTypes.
+type GetProductTypesParams struct {
+	IncludeDeprecated *bool `form:"includeDeprecated,omitempty" json:"includeDeprecated,omitempty"`
+}
+
+// PostProductTypesJSONBody defines parameters for PostProductTypes.
+type PostProductTypesJSONBody struct {
+	Name string `json:"name"`
+}
+
+// PatchProductTypesNameJSONBody defines parameters for PatchProductTypesName.
+type PatchProductTypesNameJSONBody struct {
+	Deprecated bool `json:"deprecated"`
+}
+
 // PostProductsJSONBody defines parameters for PostProducts.
 type PostProductsJSONBody struct {
-	PvzId openapi_types.UUID       `json:"pvzId"`
-	Type  PostProductsJSONBodyType `json:"type"`
+	// Barcode EAN-13 или внутренний код посылки вида PVZ0123456789
+	Barcode *Barcode `json:"barcode,omitempty"`
+
+	// Dimensions Габариты товара в сантиметрах
+	Dimensions  *ProductDimensions `json:"dimensions,omitempty"`
+	PvzId       openapi_types.UUID `json:"pvzId"`
+	Type        string             `json:"type"`
+	WeightGrams *int               `json:"weightGrams,omitempty"`
+}
+
+// PostProductsBatchJSONBody defines parameters for PostProductsBatch.
+type PostProductsBatchJSONBody struct {
+	Mode     *PostProductsBatchJSONBodyMode `json:"mode,omitempty"`
+	Products []struct {
+		// Barcode EAN-13 или внутренний код посылки вида PVZ0123456789
+		Barcode *Barcode `json:"barcode,omitempty"`
+
+		// Dimensions Габариты товара в сантиметрах
+		Dimensions  *ProductDimensions `json:"dimensions,omitempty"`
+		Type        string             `json:"type"`
+		WeightGrams *int               `json:"weightGrams,omitempty"`
+	} `json:"products"`
+	PvzId openapi_types.UUID `json:"pvzId"`
 }
 
-// PostProductsJSONBodyType defines parameters for PostProducts.
-type PostProductsJSONBodyType string
+// PostProductsBatchJSONBodyMode defines parameters for PostProductsBatch.
+type PostProductsBatchJSONBodyMode string
 
 // GetPvzParams defines parameters for GetPvz.
 type GetPvzParams struct {
@@ -158,6 +334,76 @@ type GetPvzParams struct {
 
 	// Limit Количество элементов на странице
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Sort Поле сортировки ПВЗ
+	Sort *GetPvzParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Направление сортировки
+	Order *GetPvzParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// View Объем данных в ответе: summary — все ПВЗ, включая ПВЗ без приемок за период, со счетчиками приемок и товаров,
+	// with_receptions — ПВЗ с приемками и количеством товаров в каждой, full — ПВЗ с приемками и товарами
+	View *GetPvzParamsView `form:"view,omitempty" json:"view,omitempty"`
+
+	// IncludeArchived Включать архивные ПВЗ
+	IncludeArchived *bool `form:"includeArchived,omitempty" json:"includeArchived,omitempty"`
+}
+
+// GetPvzParamsSort defines parameters for GetPvz.
+type GetPvzParamsSort string
+
+// GetPvzParamsOrder defines parameters for GetPvz.
+type GetPvzParamsOrder string
+
+// GetPvzParamsView defines parameters for GetPvz.
+type GetPvzParamsView string
+
+// GetPvzNearbyParams defines parameters for GetPvzNearby.
+type GetPvzNearbyParams struct {
+	// Lat Широта точки поиска
+	Lat float64 `form:"lat" json:"lat"`
+
+	// Lon Долгота точки поиска
+	Lon float64 `form:"lon" json:"lon"`
+
+	// Radius Радиус поиска в метрах
+	Radius *int `form:"radius,omitempty" json:"radius,omitempty"`
+
+	// Limit Максимальное количество ПВЗ в ответе
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// DeletePvzPvzIdParams defines parameters for DeletePvzPvzId.
+type DeletePvzPvzIdParams struct {
+	// IfMatch ETag ресурса или список ETag через запятую, W/ у слабых ETag не учитывается. Без заголовка или со значением * версия не проверяется
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PatchPvzPvzIdParams defines parameters for PatchPvzPvzId.
+type PatchPvzPvzIdParams struct {
+	// IfMatch ETag ресурса или список ETag через запятую, W/ у слабых ETag не учитывается. Без заголовка или со значением * версия не проверяется
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostPvzPvzIdCloseLastReceptionParams defines parameters for PostPvzPvzIdCloseLastReception.
+type PostPvzPvzIdCloseLastReceptionParams struct {
+	// IfMatch ETag ресурса или список ETag через запятую, W/ у слабых ETag не учитывается. Без заголовка или со значением * версия не проверяется
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetPvzPvzIdProductsParams defines parameters for GetPvzPvzIdProducts.
+type GetPvzPvzIdProductsParams struct {
+	// Status Только товары в указанном статусе
+	Status *GetPvzPvzIdProductsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetPvzPvzIdProductsParamsStatus defines parameters for GetPvzPvzIdProducts.
+type GetPvzPvzIdProductsParamsStatus string
+
+// PutPvzPvzIdSettingsParams defines parameters for PutPvzPvzIdSettings.
+type PutPvzPvzIdSettingsParams struct {
+	// IfMatch ETag ресурса или список ETag через запятую, W/ у слабых ETag не учитывается. Без заголовка или со значением * версия не проверяется
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
 }
 
 // PostReceptionsJSONBody defines parameters for PostReceptions.
@@ -165,6 +411,23 @@ type PostReceptionsJSONBody struct {
 	PvzId openapi_types.UUID `json:"pvzId"`
 }
 
+// PostReceptionsReceptionIdCancelJSONBody defines parameters for PostReceptionsReceptionIdCancel.
+type PostReceptionsReceptionIdCancelJSONBody struct {
+	Reason string `json:"reason"`
+}
+
+// PostReceptionsReceptionIdCancelParams defines parameters for PostReceptionsReceptionIdCancel.
+type PostReceptionsReceptionIdCancelParams struct {
+	// IfMatch ETag ресурса или список ETag через запятую, W/ у слабых ETag не учитывается. Без заголовка или со значением * версия не проверяется
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostReceptionsReceptionIdReopenParams defines parameters for PostReceptionsReceptionIdReopen.
+type PostReceptionsReceptionIdReopenParams struct {
+	// IfMatch ETag ресурса или список ETag через запятую, W/ у слабых ETag не учитывается. Без заголовка или со значением * версия не проверяется
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
 // PostRegisterJSONBody defines parameters for PostRegister.
 type PostRegisterJSONBody struct {
 	Email    openapi_types.Email      `json:"email"`
@@ -175,53 +438,201 @@ type PostRegisterJSONBody struct {
 // PostRegisterJSONBodyRole defines parameters for PostRegister.
 type PostRegisterJSONBodyRole string
 
+// GetReportsDailyParams defines parameters for GetReportsDaily.
+type GetReportsDailyParams struct {
+	// Date Дата сводки. Границы дня берутся в часовом поясе города каждого ПВЗ
+	Date openapi_types.Date `form:"date" json:"date"`
+}
+
+// PostShipmentsJSONBody defines parameters for PostShipments.
+type PostShipmentsJSONBody struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+// PostShipmentsShipmentIdProductsJSONBody defines parameters for PostShipmentsShipmentIdProducts.
+type PostShipmentsShipmentIdProductsJSONBody struct {
+	ProductId openapi_types.UUID `json:"productId"`
+}
+
+// GetStatsParams defines parameters for GetStats.
+type GetStatsParams struct {
+	// StartDate Начальная дата диапазона
+	StartDate *time.Time `form:"startDate,omitempty" json:"startDate,omitempty"`
+
+	// EndDate Конечная дата диапазона
+	EndDate *time.Time `form:"endDate,omitempty" json:"endDate,omitempty"`
+
+	// City Город ПВЗ
+	City *string `form:"city,omitempty" json:"city,omitempty"`
+
+	// PvzId Идентификатор ПВЗ
+	PvzId *openapi_types.UUID `form:"pvzId,omitempty" json:"pvzId,omitempty"`
+}
+
+// PostCitiesJSONRequestBody defines body for PostCities for application/json ContentType.
+type PostCitiesJSONRequestBody = City
+
+// PutCitiesNameJSONRequestBody defines body for PutCitiesName for application/json ContentType.
+type PutCitiesNameJSONRequestBody = City
+
 // PostDummyLoginJSONRequestBody defines body for PostDummyLogin for application/json ContentType.
 type PostDummyLoginJSONRequestBody PostDummyLoginJSONBody
 
 // PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
 type PostLoginJSONRequestBody PostLoginJSONBody
 
+// PostProductTypesJSONRequestBody defines body for PostProductTypes for application/json ContentType.
+type PostProductTypesJSONRequestBody PostProductTypesJSONBody
+
+// PatchProductTypesNameJSONRequestBody defines body for PatchProductTypesName for application/json ContentType.
+type PatchProductTypesNameJSONRequestBody PatchProductTypesNameJSONBody
+
 // PostProductsJSONRequestBody defines body for PostProducts for application/json ContentType.
 type PostProductsJSONRequestBody PostProductsJSONBody
 
+// PostProductsBatchJSONRequestBody defines body for PostProductsBatch for application/json ContentType.
+type PostProductsBatchJSONRequestBody PostProductsBatchJSONBody
+
 // PostPvzJSONRequestBody defines body for PostPvz for application/json ContentType.
 type PostPvzJSONRequestBody = PVZ
 
+// PatchPvzPvzIdJSONRequestBody defines body for PatchPvzPvzId for application/json ContentType.
+type PatchPvzPvzIdJSONRequestBody = PVZUpdate
+
+// PutPvzPvzIdSettingsJSONRequestBody defines body for PutPvzPvzIdSettings for application/json ContentType.
+type PutPvzPvzIdSettingsJSONRequestBody = PVZSettings
+
 // PostReceptionsJSONRequestBody defines body for PostReceptions for application/json ContentType.
 type PostReceptionsJSONRequestBody PostReceptionsJSONBody
 
+// PostReceptionsReceptionIdCancelJSONRequestBody defines body for PostReceptionsReceptionIdCancel for application/json ContentType.
+type PostReceptionsReceptionIdCancelJSONRequestBody PostReceptionsReceptionIdCancelJSONBody
+
 // PostRegisterJSONRequestBody defines body for PostRegister for application/json ContentType.
 type PostRegisterJSONRequestBody PostRegisterJSONBody
 
+// PostShipmentsJSONRequestBody defines body for PostShipments for application/json ContentType.
+type PostShipmentsJSONRequestBody PostShipmentsJSONBody
+
+// PostShipmentsShipmentIdProductsJSONRequestBody defines body for PostShipmentsShipmentIdProducts for application/json ContentType.
+type PostShipmentsShipmentIdProductsJSONRequestBody PostShipmentsShipmentIdProductsJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Справочник городов
+	// (GET /cities)
+	GetCities(c *gin.Context, params GetCitiesParams)
+	// Добавление города (только для модераторов)
+	// (POST /cities)
+	PostCities(c *gin.Context)
+	// Удаление города без ПВЗ (только для модераторов). Город с ПВЗ можно только деактивировать
+	// (DELETE /cities/{name})
+	DeleteCitiesName(c *gin.Context, name string)
+	// Изменение города (только для модераторов). Переименование применяется к ПВЗ города
+	// (PUT /cities/{name})
+	PutCitiesName(c *gin.Context, name string)
 	// Получение тестового токена
 	// (POST /dummyLogin)
 	PostDummyLogin(c *gin.Context)
+	// Выгрузка приемок с товарами в CSV. Время выгружается в UTC и в часовом поясе ПВЗ
+	// (GET /export/receptions.csv)
+	GetExportReceptionsCsv(c *gin.Context, params GetExportReceptionsCsvParams)
+	// Выгрузка приемок с товарами в XLSX. Время выгружается в UTC и в часовом поясе ПВЗ
+	// (GET /export/receptions.xlsx)
+	GetExportReceptionsXlsx(c *gin.Context, params GetExportReceptionsXlsxParams)
 	// Авторизация пользователя
 	// (POST /login)
 	PostLogin(c *gin.Context)
+	// Справочник типов товаров
+	// (GET /product-types)
+	GetProductTypes(c *gin.Context, params GetProductTypesParams)
+	// Добавление типа товара (только для модераторов)
+	// (POST /product-types)
+	PostProductTypes(c *gin.Context)
+	// Пометка типа товара устаревшим или его восстановление (только для модераторов)
+	// (PATCH /product-types/{name})
+	PatchProductTypesName(c *gin.Context, name string)
 	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
 	// (POST /products)
 	PostProducts(c *gin.Context)
+	// Поиск товаров по штрихкоду
+	// (GET /products/barcode/{barcode})
+	GetProductsBarcodeBarcode(c *gin.Context, barcode Barcode)
+	// Пакетное добавление товаров в текущую приемку одним запросом (только для сотрудников ПВЗ)
+	// (POST /products/batch)
+	PostProductsBatch(c *gin.Context)
+	// Выдача товара получателю (только для сотрудников ПВЗ)
+	// (POST /products/{productId}/issue)
+	PostProductsProductIdIssue(c *gin.Context, productId openapi_types.UUID)
+	// Возврат товара отправителю (только для сотрудников ПВЗ)
+	// (POST /products/{productId}/return)
+	PostProductsProductIdReturn(c *gin.Context, productId openapi_types.UUID)
+	// Передача принятого товара на хранение (только для сотрудников ПВЗ)
+	// (POST /products/{productId}/store)
+	PostProductsProductIdStore(c *gin.Context, productId openapi_types.UUID)
 	// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией
 	// (GET /pvz)
 	GetPvz(c *gin.Context, params GetPvzParams)
 	// Создание ПВЗ (только для модераторов)
 	// (POST /pvz)
 	PostPvz(c *gin.Context)
+	// Поиск активных ПВЗ рядом с точкой, отсортированных по расстоянию
+	// (GET /pvz/nearby)
+	GetPvzNearby(c *gin.Context, params GetPvzNearbyParams)
+	// Архивация ПВЗ (только для модераторов). История приемок и товаров сохраняется
+	// (DELETE /pvz/{pvzId})
+	DeletePvzPvzId(c *gin.Context, pvzId openapi_types.UUID, params DeletePvzPvzIdParams)
+	// Изменение данных ПВЗ (только для модераторов)
+	// (PATCH /pvz/{pvzId})
+	PatchPvzPvzId(c *gin.Context, pvzId openapi_types.UUID, params PatchPvzPvzIdParams)
 	// Закрытие последней открытой приемки товаров в рамках ПВЗ
 	// (POST /pvz/{pvzId}/close_last_reception)
-	PostPvzPvzIdCloseLastReception(c *gin.Context, pvzId openapi_types.UUID)
+	PostPvzPvzIdCloseLastReception(c *gin.Context, pvzId openapi_types.UUID, params PostPvzPvzIdCloseLastReceptionParams)
+	// Закрытие открытой отгрузки ПВЗ (только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/close_last_shipment)
+	PostPvzPvzIdCloseLastShipment(c *gin.Context, pvzId openapi_types.UUID)
 	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
 	// (POST /pvz/{pvzId}/delete_last_product)
 	PostPvzPvzIdDeleteLastProduct(c *gin.Context, pvzId openapi_types.UUID)
+	// Товары, находящиеся в ПВЗ (принятые и хранящиеся)
+	// (GET /pvz/{pvzId}/products)
+	GetPvzPvzIdProducts(c *gin.Context, pvzId openapi_types.UUID, params GetPvzPvzIdProductsParams)
+	// Настройка ограничений приемок ПВЗ (только для модераторов). Отсутствующее значение снимает ограничение
+	// (PUT /pvz/{pvzId}/settings)
+	PutPvzPvzIdSettings(c *gin.Context, pvzId openapi_types.UUID, params PutPvzPvzIdSettingsParams)
 	// Создание новой приемки товаров (только для сотрудников ПВЗ)
 	// (POST /receptions)
 	PostReceptions(c *gin.Context)
+	// Отмена незакрытой приемки (только для модераторов). Товары сохраняются
+	// (POST /receptions/{receptionId}/cancel)
+	PostReceptionsReceptionIdCancel(c *gin.Context, receptionId openapi_types.UUID, params PostReceptionsReceptionIdCancelParams)
+	// Удаление товара из незакрытой приемки по id (только для сотрудников ПВЗ)
+	// (DELETE /receptions/{receptionId}/products/{productId})
+	DeleteReceptionsReceptionIdProductsProductId(c *gin.Context, receptionId openapi_types.UUID, productId openapi_types.UUID)
+	// Повторное открытие последней закрытой приемки (только для модераторов)
+	// (POST /receptions/{receptionId}/reopen)
+	PostReceptionsReceptionIdReopen(c *gin.Context, receptionId openapi_types.UUID, params PostReceptionsReceptionIdReopenParams)
 	// Регистрация пользователя
 	// (POST /register)
 	PostRegister(c *gin.Context)
+	// Ежедневная сводка по приемкам и товарам в разрезе ПВЗ
+	// (GET /reports/daily)
+	GetReportsDaily(c *gin.Context, params GetReportsDailyParams)
+	// Открытие отгрузки невостребованных товаров (только для сотрудников ПВЗ)
+	// (POST /shipments)
+	PostShipments(c *gin.Context)
+	// Отгрузка и прикрепленные к ней товары
+	// (GET /shipments/{shipmentId})
+	GetShipmentsShipmentId(c *gin.Context, shipmentId openapi_types.UUID)
+	// Добавление хранящегося товара в открытую отгрузку (только для сотрудников ПВЗ)
+	// (POST /shipments/{shipmentId}/products)
+	PostShipmentsShipmentIdProducts(c *gin.Context, shipmentId openapi_types.UUID)
+	// Удаление товара из открытой отгрузки (только для сотрудников ПВЗ)
+	// (DELETE /shipments/{shipmentId}/products/{productId})
+	DeleteShipmentsShipmentIdProductsProductId(c *gin.Context, shipmentId openapi_types.UUID, productId openapi_types.UUID)
+	// Статистика по приемкам и товарам за период
+	// (GET /stats)
+	GetStats(c *gin.Context, params GetStatsParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -233,21 +644,23 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
-// PostDummyLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostDummyLogin(c *gin.Context) {
+// GetCities operation middleware
+func (siw *ServerInterfaceWrapper) GetCities(c *gin.Context) {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	var err error
 
-	siw.Handler.PostDummyLogin(c)
-}
+	c.Set(BearerAuthScopes, []string{"employee", "moderator"})
 
-// PostLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCitiesParams
+
+	// ------------- Optional query parameter "includeInactive" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeInactive", c.Request.URL.Query(), &params.IncludeInactive)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter includeInactive: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -256,13 +669,13 @@ func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostLogin(c)
+	siw.Handler.GetCities(c, params)
 }
 
-// PostProducts operation middleware
-func (siw *ServerInterfaceWrapper) PostProducts(c *gin.Context) {
+// PostCities operation middleware
+func (siw *ServerInterfaceWrapper) PostCities(c *gin.Context) {
 
-	c.Set(BearerAuthScopes, []string{})
+	c.Set(BearerAuthScopes, []string{"moderator"})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -271,51 +684,51 @@ func (siw *ServerInterfaceWrapper) PostProducts(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostProducts(c)
+	siw.Handler.PostCities(c)
 }
 
-// GetPvz operation middleware
-func (siw *ServerInterfaceWrapper) GetPvz(c *gin.Context) {
+// DeleteCitiesName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCitiesName(c *gin.Context) {
 
 	var err error
 
-	c.Set(BearerAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPvzParams
-
-	// ------------- Optional query parameter "startDate" -------------
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindQueryParameter("form", true, false, "startDate", c.Request.URL.Query(), &params.StartDate)
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "endDate" -------------
+	c.Set(BearerAuthScopes, []string{"moderator"})
 
-	err = runtime.BindQueryParameter("form", true, false, "endDate", c.Request.URL.Query(), &params.EndDate)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	siw.Handler.DeleteCitiesName(c, name)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
-		return
-	}
+// PutCitiesName operation middleware
+func (siw *ServerInterfaceWrapper) PutCitiesName(c *gin.Context) {
 
-	// ------------- Optional query parameter "limit" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(BearerAuthScopes, []string{"moderator"})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -323,13 +736,11 @@ func (siw *ServerInterfaceWrapper) GetPvz(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetPvz(c, params)
+	siw.Handler.PutCitiesName(c, name)
 }
 
-// PostPvz operation middleware
-func (siw *ServerInterfaceWrapper) PostPvz(c *gin.Context) {
-
-	c.Set(BearerAuthScopes, []string{})
+// PostDummyLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostDummyLogin(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -338,24 +749,42 @@ func (siw *ServerInterfaceWrapper) PostPvz(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostPvz(c)
+	siw.Handler.PostDummyLogin(c)
 }
 
-// PostPvzPvzIdCloseLastReception operation middleware
-func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(c *gin.Context) {
+// GetExportReceptionsCsv operation middleware
+func (siw *ServerInterfaceWrapper) GetExportReceptionsCsv(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "pvzId" -------------
-	var pvzId openapi_types.UUID
+	c.Set(BearerAuthScopes, []string{"employee", "moderator"})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetExportReceptionsCsvParams
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", c.Request.URL.Query(), &params.StartDate)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "city" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "city", c.Request.URL.Query(), &params.City)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter city: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -364,39 +793,42 @@ func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(c *gin.Context
 		}
 	}
 
-	siw.Handler.PostPvzPvzIdCloseLastReception(c, pvzId)
+	siw.Handler.GetExportReceptionsCsv(c, params)
 }
 
-// PostPvzPvzIdDeleteLastProduct operation middleware
-func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(c *gin.Context) {
+// GetExportReceptionsXlsx operation middleware
+func (siw *ServerInterfaceWrapper) GetExportReceptionsXlsx(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "pvzId" -------------
-	var pvzId openapi_types.UUID
+	c.Set(BearerAuthScopes, []string{"employee", "moderator"})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetExportReceptionsXlsxParams
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", c.Request.URL.Query(), &params.StartDate)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "endDate" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	err = runtime.BindQueryParameter("form", true, false, "endDate", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.PostPvzPvzIdDeleteLastProduct(c, pvzId)
-}
-
-// PostReceptions operation middleware
-func (siw *ServerInterfaceWrapper) PostReceptions(c *gin.Context) {
+	// ------------- Optional query parameter "city" -------------
 
-	c.Set(BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "city", c.Request.URL.Query(), &params.City)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter city: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -405,11 +837,11 @@ func (siw *ServerInterfaceWrapper) PostReceptions(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostReceptions(c)
+	siw.Handler.GetExportReceptionsXlsx(c, params)
 }
 
-// PostRegister operation middleware
-func (siw *ServerInterfaceWrapper) PostRegister(c *gin.Context) {
+// PostLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -418,370 +850,4705 @@ func (siw *ServerInterfaceWrapper) PostRegister(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostRegister(c)
+	siw.Handler.PostLogin(c)
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
-}
+// GetProductTypes operation middleware
+func (siw *ServerInterfaceWrapper) GetProductTypes(c *gin.Context) {
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+	var err error
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
-		}
-	}
+	c.Set(BearerAuthScopes, []string{"employee", "moderator"})
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProductTypesParams
+
+	// ------------- Optional query parameter "includeDeprecated" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeDeprecated", c.Request.URL.Query(), &params.IncludeDeprecated)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter includeDeprecated: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	router.POST(options.BaseURL+"/dummyLogin", wrapper.PostDummyLogin)
-	router.POST(options.BaseURL+"/login", wrapper.PostLogin)
-	router.POST(options.BaseURL+"/products", wrapper.PostProducts)
-	router.GET(options.BaseURL+"/pvz", wrapper.GetPvz)
-	router.POST(options.BaseURL+"/pvz", wrapper.PostPvz)
-	router.POST(options.BaseURL+"/pvz/:pvzId/close_last_reception", wrapper.PostPvzPvzIdCloseLastReception)
-	router.POST(options.BaseURL+"/pvz/:pvzId/delete_last_product", wrapper.PostPvzPvzIdDeleteLastProduct)
-	router.POST(options.BaseURL+"/receptions", wrapper.PostReceptions)
-	router.POST(options.BaseURL+"/register", wrapper.PostRegister)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type PostDummyLoginRequestObject struct {
-	Body *PostDummyLoginJSONRequestBody
+	siw.Handler.GetProductTypes(c, params)
 }
 
-type PostDummyLoginResponseObject interface {
-	VisitPostDummyLoginResponse(w http.ResponseWriter) error
-}
+// PostProductTypes operation middleware
+func (siw *ServerInterfaceWrapper) PostProductTypes(c *gin.Context) {
 
-type PostDummyLogin200JSONResponse Token
+	c.Set(BearerAuthScopes, []string{"moderator"})
 
-func (response PostDummyLogin200JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.PostProductTypes(c)
 }
 
-type PostDummyLogin400JSONResponse Error
+// PatchProductTypesName operation middleware
+func (siw *ServerInterfaceWrapper) PatchProductTypesName(c *gin.Context) {
 
-func (response PostDummyLogin400JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type PostLoginRequestObject struct {
-	Body *PostLoginJSONRequestBody
-}
+	// ------------- Path parameter "name" -------------
+	var name string
 
-type PostLoginResponseObject interface {
-	VisitPostLoginResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostLogin200JSONResponse Token
+	c.Set(BearerAuthScopes, []string{"moderator"})
 
-func (response PostLogin200JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.PatchProductTypesName(c, name)
 }
 
-type PostLogin401JSONResponse Error
+// PostProducts operation middleware
+func (siw *ServerInterfaceWrapper) PostProducts(c *gin.Context) {
 
-func (response PostLogin401JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	c.Set(BearerAuthScopes, []string{"employee"})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type PostProductsRequestObject struct {
-	Body *PostProductsJSONRequestBody
+	siw.Handler.PostProducts(c)
 }
 
-type PostProductsResponseObject interface {
-	VisitPostProductsResponse(w http.ResponseWriter) error
-}
+// GetProductsBarcodeBarcode operation middleware
+func (siw *ServerInterfaceWrapper) GetProductsBarcodeBarcode(c *gin.Context) {
 
-type PostProducts201JSONResponse Product
+	var err error
 
-func (response PostProducts201JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Path parameter "barcode" -------------
+	var barcode Barcode
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "barcode", c.Param("barcode"), &barcode, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter barcode: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostProducts400JSONResponse Error
+	c.Set(BearerAuthScopes, []string{"employee", "moderator"})
 
-func (response PostProducts400JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.GetProductsBarcodeBarcode(c, barcode)
 }
 
-type PostProducts403JSONResponse Error
-
-func (response PostProducts403JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// PostProductsBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsBatch(c *gin.Context) {
 
-type GetPvzRequestObject struct {
-	Params GetPvzParams
-}
+	c.Set(BearerAuthScopes, []string{"employee"})
 
-type GetPvzResponseObject interface {
-	VisitGetPvzResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetPvz200JSONResponse []struct {
-	Pvz        *PVZ `json:"pvz,omitempty"`
-	Receptions *[]struct {
-		Products  *[]Product `json:"products,omitempty"`
-		Reception *Reception `json:"reception,omitempty"`
-	} `json:"receptions,omitempty"`
+	siw.Handler.PostProductsBatch(c)
 }
 
-func (response GetPvz200JSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostProductsProductIdIssue operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsProductIdIssue(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type PostPvzRequestObject struct {
-	Body *PostPvzJSONRequestBody
-}
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
 
-type PostPvzResponseObject interface {
-	VisitPostPvzResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Param("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostPvz201JSONResponse PVZ
+	c.Set(BearerAuthScopes, []string{"employee"})
 
-func (response PostPvz201JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.PostProductsProductIdIssue(c, productId)
 }
 
-type PostPvz400JSONResponse Error
+// PostProductsProductIdReturn operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsProductIdReturn(c *gin.Context) {
 
-func (response PostPvz400JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
 
-type PostPvz403JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Param("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostPvz403JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	c.Set(BearerAuthScopes, []string{"employee"})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type PostPvzPvzIdCloseLastReceptionRequestObject struct {
-	PvzId openapi_types.UUID `json:"pvzId"`
+	siw.Handler.PostProductsProductIdReturn(c, productId)
 }
 
-type PostPvzPvzIdCloseLastReceptionResponseObject interface {
-	VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error
-}
+// PostProductsProductIdStore operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsProductIdStore(c *gin.Context) {
 
-type PostPvzPvzIdCloseLastReception200JSONResponse Reception
+	var err error
 
-func (response PostPvzPvzIdCloseLastReception200JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Param("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostPvzPvzIdCloseLastReception400JSONResponse Error
+	c.Set(BearerAuthScopes, []string{"employee"})
 
-func (response PostPvzPvzIdCloseLastReception400JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.PostProductsProductIdStore(c, productId)
 }
 
-type PostPvzPvzIdCloseLastReception403JSONResponse Error
-
-func (response PostPvzPvzIdCloseLastReception403JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+// GetPvz operation middleware
+func (siw *ServerInterfaceWrapper) GetPvz(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type PostPvzPvzIdDeleteLastProductRequestObject struct {
-	PvzId openapi_types.UUID `json:"pvzId"`
-}
+	c.Set(BearerAuthScopes, []string{"employee", "moderator"})
 
-type PostPvzPvzIdDeleteLastProductResponseObject interface {
-	VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzParams
 
-type PostPvzPvzIdDeleteLastProduct200Response struct {
-}
+	// ------------- Optional query parameter "startDate" -------------
 
-func (response PostPvzPvzIdDeleteLastProduct200Response) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "startDate", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostPvzPvzIdDeleteLastProduct400JSONResponse Error
+	// ------------- Optional query parameter "endDate" -------------
 
-func (response PostPvzPvzIdDeleteLastProduct400JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindQueryParameter("form", true, false, "endDate", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "page" -------------
 
-type PostPvzPvzIdDeleteLastProduct403JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostPvzPvzIdDeleteLastProduct403JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	// ------------- Optional query parameter "limit" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", c.Request.URL.Query(), &params.View)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter view: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "includeArchived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeArchived", c.Request.URL.Query(), &params.IncludeArchived)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter includeArchived: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPvz(c, params)
+}
+
+// PostPvz operation middleware
+func (siw *ServerInterfaceWrapper) PostPvz(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{"moderator"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvz(c)
+}
+
+// GetPvzNearby operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzNearby(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{"employee", "moderator"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzNearbyParams
+
+	// ------------- Required query parameter "lat" -------------
+
+	if paramValue := c.Query("lat"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument lat is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "lat", c.Request.URL.Query(), &params.Lat)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lat: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "lon" -------------
+
+	if paramValue := c.Query("lon"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument lon is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "lon", c.Request.URL.Query(), &params.Lon)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter lon: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "radius" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "radius", c.Request.URL.Query(), &params.Radius)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter radius: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPvzNearby(c, params)
+}
+
+// DeletePvzPvzId operation middleware
+func (siw *ServerInterfaceWrapper) DeletePvzPvzId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"moderator"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeletePvzPvzIdParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeletePvzPvzId(c, pvzId, params)
+}
+
+// PatchPvzPvzId operation middleware
+func (siw *ServerInterfaceWrapper) PatchPvzPvzId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"moderator"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchPvzPvzIdParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchPvzPvzId(c, pvzId, params)
+}
+
+// PostPvzPvzIdCloseLastReception operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"employee"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPvzPvzIdCloseLastReceptionParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvzPvzIdCloseLastReception(c, pvzId, params)
+}
+
+// PostPvzPvzIdCloseLastShipment operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastShipment(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"employee"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvzPvzIdCloseLastShipment(c, pvzId)
+}
+
+// PostPvzPvzIdDeleteLastProduct operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"employee"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvzPvzIdDeleteLastProduct(c, pvzId)
+}
+
+// GetPvzPvzIdProducts operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzPvzIdProducts(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"employee", "moderator"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzPvzIdProductsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPvzPvzIdProducts(c, pvzId, params)
+}
+
+// PutPvzPvzIdSettings operation middleware
+func (siw *ServerInterfaceWrapper) PutPvzPvzIdSettings(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"moderator"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutPvzPvzIdSettingsParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutPvzPvzIdSettings(c, pvzId, params)
+}
+
+// PostReceptions operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptions(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{"employee"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostReceptions(c)
+}
+
+// PostReceptionsReceptionIdCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptionsReceptionIdCancel(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", c.Param("receptionId"), &receptionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter receptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"moderator"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostReceptionsReceptionIdCancelParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostReceptionsReceptionIdCancel(c, receptionId, params)
+}
+
+// DeleteReceptionsReceptionIdProductsProductId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteReceptionsReceptionIdProductsProductId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", c.Param("receptionId"), &receptionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter receptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Param("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"employee"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteReceptionsReceptionIdProductsProductId(c, receptionId, productId)
+}
+
+// PostReceptionsReceptionIdReopen operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptionsReceptionIdReopen(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", c.Param("receptionId"), &receptionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter receptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"moderator"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostReceptionsReceptionIdReopenParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostReceptionsReceptionIdReopen(c, receptionId, params)
+}
+
+// PostRegister operation middleware
+func (siw *ServerInterfaceWrapper) PostRegister(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostRegister(c)
+}
+
+// GetReportsDaily operation middleware
+func (siw *ServerInterfaceWrapper) GetReportsDaily(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{"employee", "moderator"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsDailyParams
+
+	// ------------- Required query parameter "date" -------------
+
+	if paramValue := c.Query("date"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument date is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "date", c.Request.URL.Query(), &params.Date)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter date: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetReportsDaily(c, params)
+}
+
+// PostShipments operation middleware
+func (siw *ServerInterfaceWrapper) PostShipments(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{"employee"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostShipments(c)
+}
+
+// GetShipmentsShipmentId operation middleware
+func (siw *ServerInterfaceWrapper) GetShipmentsShipmentId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "shipmentId" -------------
+	var shipmentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "shipmentId", c.Param("shipmentId"), &shipmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter shipmentId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"employee", "moderator"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetShipmentsShipmentId(c, shipmentId)
+}
+
+// PostShipmentsShipmentIdProducts operation middleware
+func (siw *ServerInterfaceWrapper) PostShipmentsShipmentIdProducts(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "shipmentId" -------------
+	var shipmentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "shipmentId", c.Param("shipmentId"), &shipmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter shipmentId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"employee"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostShipmentsShipmentIdProducts(c, shipmentId)
+}
+
+// DeleteShipmentsShipmentIdProductsProductId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteShipmentsShipmentIdProductsProductId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "shipmentId" -------------
+	var shipmentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "shipmentId", c.Param("shipmentId"), &shipmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter shipmentId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Param("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{"employee"})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteShipmentsShipmentIdProductsProductId(c, shipmentId, productId)
+}
+
+// GetStats operation middleware
+func (siw *ServerInterfaceWrapper) GetStats(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{"employee", "moderator"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsParams
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "city" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "city", c.Request.URL.Query(), &params.City)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter city: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "pvzId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pvzId", c.Request.URL.Query(), &params.PvzId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStats(c, params)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/cities", wrapper.GetCities)
+	router.POST(options.BaseURL+"/cities", wrapper.PostCities)
+	router.DELETE(options.BaseURL+"/cities/:name", wrapper.DeleteCitiesName)
+	router.PUT(options.BaseURL+"/cities/:name", wrapper.PutCitiesName)
+	router.POST(options.BaseURL+"/dummyLogin", wrapper.PostDummyLogin)
+	router.GET(options.BaseURL+"/export/receptions.csv", wrapper.GetExportReceptionsCsv)
+	router.GET(options.BaseURL+"/export/receptions.xlsx", wrapper.GetExportReceptionsXlsx)
+	router.POST(options.BaseURL+"/login", wrapper.PostLogin)
+	router.GET(options.BaseURL+"/product-types", wrapper.GetProductTypes)
+	router.POST(options.BaseURL+"/product-types", wrapper.PostProductTypes)
+	router.PATCH(options.BaseURL+"/product-types/:name", wrapper.PatchProductTypesName)
+	router.POST(options.BaseURL+"/products", wrapper.PostProducts)
+	router.GET(options.BaseURL+"/products/barcode/:barcode", wrapper.GetProductsBarcodeBarcode)
+	router.POST(options.BaseURL+"/products/batch", wrapper.PostProductsBatch)
+	router.POST(options.BaseURL+"/products/:productId/issue", wrapper.PostProductsProductIdIssue)
+	router.POST(options.BaseURL+"/products/:productId/return", wrapper.PostProductsProductIdReturn)
+	router.POST(options.BaseURL+"/products/:productId/store", wrapper.PostProductsProductIdStore)
+	router.GET(options.BaseURL+"/pvz", wrapper.GetPvz)
+	router.POST(options.BaseURL+"/pvz", wrapper.PostPvz)
+	router.GET(options.BaseURL+"/pvz/nearby", wrapper.GetPvzNearby)
+	router.DELETE(options.BaseURL+"/pvz/:pvzId", wrapper.DeletePvzPvzId)
+	router.PATCH(options.BaseURL+"/pvz/:pvzId", wrapper.PatchPvzPvzId)
+	router.POST(options.BaseURL+"/pvz/:pvzId/close_last_reception", wrapper.PostPvzPvzIdCloseLastReception)
+	router.POST(options.BaseURL+"/pvz/:pvzId/close_last_shipment", wrapper.PostPvzPvzIdCloseLastShipment)
+	router.POST(options.BaseURL+"/pvz/:pvzId/delete_last_product", wrapper.PostPvzPvzIdDeleteLastProduct)
+	router.GET(options.BaseURL+"/pvz/:pvzId/products", wrapper.GetPvzPvzIdProducts)
+	router.PUT(options.BaseURL+"/pvz/:pvzId/settings", wrapper.PutPvzPvzIdSettings)
+	router.POST(options.BaseURL+"/receptions", wrapper.PostReceptions)
+	router.POST(options.BaseURL+"/receptions/:receptionId/cancel", wrapper.PostReceptionsReceptionIdCancel)
+	router.DELETE(options.BaseURL+"/receptions/:receptionId/products/:productId", wrapper.DeleteReceptionsReceptionIdProductsProductId)
+	router.POST(options.BaseURL+"/receptions/:receptionId/reopen", wrapper.PostReceptionsReceptionIdReopen)
+	router.POST(options.BaseURL+"/register", wrapper.PostRegister)
+	router.GET(options.BaseURL+"/reports/daily", wrapper.GetReportsDaily)
+	router.POST(options.BaseURL+"/shipments", wrapper.PostShipments)
+	router.GET(options.BaseURL+"/shipments/:shipmentId", wrapper.GetShipmentsShipmentId)
+	router.POST(options.BaseURL+"/shipments/:shipmentId/products", wrapper.PostShipmentsShipmentIdProducts)
+	router.DELETE(options.BaseURL+"/shipments/:shipmentId/products/:productId", wrapper.DeleteShipmentsShipmentIdProductsProductId)
+	router.GET(options.BaseURL+"/stats", wrapper.GetStats)
+}
+
+type ForbiddenApplicationProblemPlusJSONResponse Error
+
+type InternalErrorApplicationProblemPlusJSONResponse Error
+
+type PreconditionFailedApplicationProblemPlusJSONResponse Error
+
+type UnauthorizedApplicationProblemPlusJSONResponse Error
+
+type GetCitiesRequestObject struct {
+	Params GetCitiesParams
+}
+
+type GetCitiesResponseObject interface {
+	VisitGetCitiesResponse(w http.ResponseWriter) error
+}
+
+type GetCities200JSONResponse []City
+
+func (response GetCities200JSONResponse) VisitGetCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCities400ApplicationProblemPlusJSONResponse Error
+
+func (response GetCities400ApplicationProblemPlusJSONResponse) VisitGetCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCities401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetCities401ApplicationProblemPlusJSONResponse) VisitGetCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCities403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetCities403ApplicationProblemPlusJSONResponse) VisitGetCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCities500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetCities500ApplicationProblemPlusJSONResponse) VisitGetCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCitiesRequestObject struct {
+	Body *PostCitiesJSONRequestBody
+}
+
+type PostCitiesResponseObject interface {
+	VisitPostCitiesResponse(w http.ResponseWriter) error
+}
+
+type PostCities201JSONResponse City
+
+func (response PostCities201JSONResponse) VisitPostCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCities400ApplicationProblemPlusJSONResponse Error
+
+func (response PostCities400ApplicationProblemPlusJSONResponse) VisitPostCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCities401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostCities401ApplicationProblemPlusJSONResponse) VisitPostCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCities403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostCities403ApplicationProblemPlusJSONResponse) VisitPostCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCities409ApplicationProblemPlusJSONResponse Error
+
+func (response PostCities409ApplicationProblemPlusJSONResponse) VisitPostCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCities500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostCities500ApplicationProblemPlusJSONResponse) VisitPostCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCitiesNameRequestObject struct {
+	Name string `json:"name"`
+}
+
+type DeleteCitiesNameResponseObject interface {
+	VisitDeleteCitiesNameResponse(w http.ResponseWriter) error
+}
+
+type DeleteCitiesName204Response struct {
+}
+
+func (response DeleteCitiesName204Response) VisitDeleteCitiesNameResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteCitiesName401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteCitiesName401ApplicationProblemPlusJSONResponse) VisitDeleteCitiesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCitiesName403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteCitiesName403ApplicationProblemPlusJSONResponse) VisitDeleteCitiesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCitiesName404ApplicationProblemPlusJSONResponse Error
+
+func (response DeleteCitiesName404ApplicationProblemPlusJSONResponse) VisitDeleteCitiesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCitiesName409ApplicationProblemPlusJSONResponse Error
+
+func (response DeleteCitiesName409ApplicationProblemPlusJSONResponse) VisitDeleteCitiesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCitiesName500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteCitiesName500ApplicationProblemPlusJSONResponse) VisitDeleteCitiesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCitiesNameRequestObject struct {
+	Name string `json:"name"`
+	Body *PutCitiesNameJSONRequestBody
+}
+
+type PutCitiesNameResponseObject interface {
+	VisitPutCitiesNameResponse(w http.ResponseWriter) error
+}
+
+type PutCitiesName200JSONResponse City
+
+func (response PutCitiesName200JSONResponse) VisitPutCitiesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCitiesName400ApplicationProblemPlusJSONResponse Error
+
+func (response PutCitiesName400ApplicationProblemPlusJSONResponse) VisitPutCitiesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCitiesName401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PutCitiesName401ApplicationProblemPlusJSONResponse) VisitPutCitiesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCitiesName403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PutCitiesName403ApplicationProblemPlusJSONResponse) VisitPutCitiesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCitiesName404ApplicationProblemPlusJSONResponse Error
+
+func (response PutCitiesName404ApplicationProblemPlusJSONResponse) VisitPutCitiesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutCitiesName500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PutCitiesName500ApplicationProblemPlusJSONResponse) VisitPutCitiesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDummyLoginRequestObject struct {
+	Body *PostDummyLoginJSONRequestBody
+}
+
+type PostDummyLoginResponseObject interface {
+	VisitPostDummyLoginResponse(w http.ResponseWriter) error
+}
+
+type PostDummyLogin200JSONResponse Token
+
+func (response PostDummyLogin200JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDummyLogin400ApplicationProblemPlusJSONResponse Error
+
+func (response PostDummyLogin400ApplicationProblemPlusJSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDummyLogin500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostDummyLogin500ApplicationProblemPlusJSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExportReceptionsCsvRequestObject struct {
+	Params GetExportReceptionsCsvParams
+}
+
+type GetExportReceptionsCsvResponseObject interface {
+	VisitGetExportReceptionsCsvResponse(w http.ResponseWriter) error
+}
+
+type GetExportReceptionsCsv200ResponseHeaders struct {
+	ContentDisposition string
+	XExportStatus      string
+}
+
+type GetExportReceptionsCsv200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       GetExportReceptionsCsv200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetExportReceptionsCsv200TextcsvResponse) VisitGetExportReceptionsCsvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.Header().Set("X-Export-Status", fmt.Sprint(response.Headers.XExportStatus))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetExportReceptionsCsv400ApplicationProblemPlusJSONResponse Error
+
+func (response GetExportReceptionsCsv400ApplicationProblemPlusJSONResponse) VisitGetExportReceptionsCsvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExportReceptionsCsv401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetExportReceptionsCsv401ApplicationProblemPlusJSONResponse) VisitGetExportReceptionsCsvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExportReceptionsCsv403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetExportReceptionsCsv403ApplicationProblemPlusJSONResponse) VisitGetExportReceptionsCsvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExportReceptionsCsv500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetExportReceptionsCsv500ApplicationProblemPlusJSONResponse) VisitGetExportReceptionsCsvResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExportReceptionsXlsxRequestObject struct {
+	Params GetExportReceptionsXlsxParams
+}
+
+type GetExportReceptionsXlsxResponseObject interface {
+	VisitGetExportReceptionsXlsxResponse(w http.ResponseWriter) error
+}
+
+type GetExportReceptionsXlsx200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type GetExportReceptionsXlsx200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse struct {
+	Body          io.Reader
+	Headers       GetExportReceptionsXlsx200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetExportReceptionsXlsx200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse) VisitGetExportReceptionsXlsxResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetExportReceptionsXlsx400ApplicationProblemPlusJSONResponse Error
+
+func (response GetExportReceptionsXlsx400ApplicationProblemPlusJSONResponse) VisitGetExportReceptionsXlsxResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExportReceptionsXlsx401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetExportReceptionsXlsx401ApplicationProblemPlusJSONResponse) VisitGetExportReceptionsXlsxResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExportReceptionsXlsx403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetExportReceptionsXlsx403ApplicationProblemPlusJSONResponse) VisitGetExportReceptionsXlsxResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExportReceptionsXlsx422ApplicationProblemPlusJSONResponse Error
+
+func (response GetExportReceptionsXlsx422ApplicationProblemPlusJSONResponse) VisitGetExportReceptionsXlsxResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExportReceptionsXlsx500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetExportReceptionsXlsx500ApplicationProblemPlusJSONResponse) VisitGetExportReceptionsXlsxResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLoginRequestObject struct {
+	Body *PostLoginJSONRequestBody
+}
+
+type PostLoginResponseObject interface {
+	VisitPostLoginResponse(w http.ResponseWriter) error
+}
+
+type PostLogin200JSONResponse Token
+
+func (response PostLogin200JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogin400ApplicationProblemPlusJSONResponse Error
+
+func (response PostLogin400ApplicationProblemPlusJSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogin401ApplicationProblemPlusJSONResponse Error
+
+func (response PostLogin401ApplicationProblemPlusJSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogin500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostLogin500ApplicationProblemPlusJSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductTypesRequestObject struct {
+	Params GetProductTypesParams
+}
+
+type GetProductTypesResponseObject interface {
+	VisitGetProductTypesResponse(w http.ResponseWriter) error
+}
+
+type GetProductTypes200JSONResponse []ProductType
+
+func (response GetProductTypes200JSONResponse) VisitGetProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductTypes400ApplicationProblemPlusJSONResponse Error
+
+func (response GetProductTypes400ApplicationProblemPlusJSONResponse) VisitGetProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductTypes401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetProductTypes401ApplicationProblemPlusJSONResponse) VisitGetProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductTypes403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetProductTypes403ApplicationProblemPlusJSONResponse) VisitGetProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductTypes500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetProductTypes500ApplicationProblemPlusJSONResponse) VisitGetProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductTypesRequestObject struct {
+	Body *PostProductTypesJSONRequestBody
+}
+
+type PostProductTypesResponseObject interface {
+	VisitPostProductTypesResponse(w http.ResponseWriter) error
+}
+
+type PostProductTypes201JSONResponse ProductType
+
+func (response PostProductTypes201JSONResponse) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductTypes400ApplicationProblemPlusJSONResponse Error
+
+func (response PostProductTypes400ApplicationProblemPlusJSONResponse) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductTypes401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostProductTypes401ApplicationProblemPlusJSONResponse) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductTypes403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostProductTypes403ApplicationProblemPlusJSONResponse) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductTypes409ApplicationProblemPlusJSONResponse Error
+
+func (response PostProductTypes409ApplicationProblemPlusJSONResponse) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductTypes500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostProductTypes500ApplicationProblemPlusJSONResponse) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProductTypesNameRequestObject struct {
+	Name string `json:"name"`
+	Body *PatchProductTypesNameJSONRequestBody
+}
+
+type PatchProductTypesNameResponseObject interface {
+	VisitPatchProductTypesNameResponse(w http.ResponseWriter) error
+}
+
+type PatchProductTypesName200JSONResponse ProductType
+
+func (response PatchProductTypesName200JSONResponse) VisitPatchProductTypesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProductTypesName400ApplicationProblemPlusJSONResponse Error
+
+func (response PatchProductTypesName400ApplicationProblemPlusJSONResponse) VisitPatchProductTypesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProductTypesName401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PatchProductTypesName401ApplicationProblemPlusJSONResponse) VisitPatchProductTypesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProductTypesName403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PatchProductTypesName403ApplicationProblemPlusJSONResponse) VisitPatchProductTypesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProductTypesName404ApplicationProblemPlusJSONResponse Error
+
+func (response PatchProductTypesName404ApplicationProblemPlusJSONResponse) VisitPatchProductTypesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProductTypesName500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PatchProductTypesName500ApplicationProblemPlusJSONResponse) VisitPatchProductTypesNameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsRequestObject struct {
+	Body *PostProductsJSONRequestBody
+}
+
+type PostProductsResponseObject interface {
+	VisitPostProductsResponse(w http.ResponseWriter) error
+}
+
+type PostProducts201JSONResponse Product
+
+func (response PostProducts201JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProducts400ApplicationProblemPlusJSONResponse Error
+
+func (response PostProducts400ApplicationProblemPlusJSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProducts401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostProducts401ApplicationProblemPlusJSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProducts403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostProducts403ApplicationProblemPlusJSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProducts409ApplicationProblemPlusJSONResponse Error
+
+func (response PostProducts409ApplicationProblemPlusJSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProducts500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostProducts500ApplicationProblemPlusJSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsBarcodeBarcodeRequestObject struct {
+	Barcode Barcode `json:"barcode"`
+}
+
+type GetProductsBarcodeBarcodeResponseObject interface {
+	VisitGetProductsBarcodeBarcodeResponse(w http.ResponseWriter) error
+}
+
+type GetProductsBarcodeBarcode200JSONResponse []Product
+
+func (response GetProductsBarcodeBarcode200JSONResponse) VisitGetProductsBarcodeBarcodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsBarcodeBarcode400ApplicationProblemPlusJSONResponse Error
+
+func (response GetProductsBarcodeBarcode400ApplicationProblemPlusJSONResponse) VisitGetProductsBarcodeBarcodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsBarcodeBarcode401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetProductsBarcodeBarcode401ApplicationProblemPlusJSONResponse) VisitGetProductsBarcodeBarcodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsBarcodeBarcode403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetProductsBarcodeBarcode403ApplicationProblemPlusJSONResponse) VisitGetProductsBarcodeBarcodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsBarcodeBarcode500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetProductsBarcodeBarcode500ApplicationProblemPlusJSONResponse) VisitGetProductsBarcodeBarcodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsBatchRequestObject struct {
+	Body *PostProductsBatchJSONRequestBody
+}
+
+type PostProductsBatchResponseObject interface {
+	VisitPostProductsBatchResponse(w http.ResponseWriter) error
+}
+
+type PostProductsBatch201JSONResponse ProductBatch
+
+func (response PostProductsBatch201JSONResponse) VisitPostProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsBatch400ApplicationProblemPlusJSONResponse Error
+
+func (response PostProductsBatch400ApplicationProblemPlusJSONResponse) VisitPostProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsBatch401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostProductsBatch401ApplicationProblemPlusJSONResponse) VisitPostProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsBatch403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostProductsBatch403ApplicationProblemPlusJSONResponse) VisitPostProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsBatch409ApplicationProblemPlusJSONResponse Error
+
+func (response PostProductsBatch409ApplicationProblemPlusJSONResponse) VisitPostProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsBatch422JSONResponse ProductBatch
+
+func (response PostProductsBatch422JSONResponse) VisitPostProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsBatch500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostProductsBatch500ApplicationProblemPlusJSONResponse) VisitPostProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssueRequestObject struct {
+	ProductId openapi_types.UUID `json:"productId"`
+}
+
+type PostProductsProductIdIssueResponseObject interface {
+	VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error
+}
+
+type PostProductsProductIdIssue200JSONResponse Product
+
+func (response PostProductsProductIdIssue200JSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssue400ApplicationProblemPlusJSONResponse Error
+
+func (response PostProductsProductIdIssue400ApplicationProblemPlusJSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssue401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostProductsProductIdIssue401ApplicationProblemPlusJSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssue403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostProductsProductIdIssue403ApplicationProblemPlusJSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssue404ApplicationProblemPlusJSONResponse Error
+
+func (response PostProductsProductIdIssue404ApplicationProblemPlusJSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssue409ApplicationProblemPlusJSONResponse Error
+
+func (response PostProductsProductIdIssue409ApplicationProblemPlusJSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssue500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostProductsProductIdIssue500ApplicationProblemPlusJSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdReturnRequestObject struct {
+	ProductId openapi_types.UUID `json:"productId"`
+}
+
+type PostProductsProductIdReturnResponseObject interface {
+	VisitPostProductsProductIdReturnResponse(w http.ResponseWriter) error
+}
+
+type PostProductsProductIdReturn200JSONResponse Product
+
+func (response PostProductsProductIdReturn200JSONResponse) VisitPostProductsProductIdReturnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdReturn400ApplicationProblemPlusJSONResponse Error
+
+func (response PostProductsProductIdReturn400ApplicationProblemPlusJSONResponse) VisitPostProductsProductIdReturnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdReturn401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostProductsProductIdReturn401ApplicationProblemPlusJSONResponse) VisitPostProductsProductIdReturnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdReturn403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostProductsProductIdReturn403ApplicationProblemPlusJSONResponse) VisitPostProductsProductIdReturnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdReturn404ApplicationProblemPlusJSONResponse Error
+
+func (response PostProductsProductIdReturn404ApplicationProblemPlusJSONResponse) VisitPostProductsProductIdReturnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdReturn409ApplicationProblemPlusJSONResponse Error
+
+func (response PostProductsProductIdReturn409ApplicationProblemPlusJSONResponse) VisitPostProductsProductIdReturnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdReturn500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostProductsProductIdReturn500ApplicationProblemPlusJSONResponse) VisitPostProductsProductIdReturnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdStoreRequestObject struct {
+	ProductId openapi_types.UUID `json:"productId"`
+}
+
+type PostProductsProductIdStoreResponseObject interface {
+	VisitPostProductsProductIdStoreResponse(w http.ResponseWriter) error
+}
+
+type PostProductsProductIdStore200JSONResponse Product
+
+func (response PostProductsProductIdStore200JSONResponse) VisitPostProductsProductIdStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdStore400ApplicationProblemPlusJSONResponse Error
+
+func (response PostProductsProductIdStore400ApplicationProblemPlusJSONResponse) VisitPostProductsProductIdStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdStore401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostProductsProductIdStore401ApplicationProblemPlusJSONResponse) VisitPostProductsProductIdStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdStore403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostProductsProductIdStore403ApplicationProblemPlusJSONResponse) VisitPostProductsProductIdStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdStore404ApplicationProblemPlusJSONResponse Error
+
+func (response PostProductsProductIdStore404ApplicationProblemPlusJSONResponse) VisitPostProductsProductIdStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdStore409ApplicationProblemPlusJSONResponse Error
+
+func (response PostProductsProductIdStore409ApplicationProblemPlusJSONResponse) VisitPostProductsProductIdStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdStore500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostProductsProductIdStore500ApplicationProblemPlusJSONResponse) VisitPostProductsProductIdStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzRequestObject struct {
+	Params GetPvzParams
+}
+
+type GetPvzResponseObject interface {
+	VisitGetPvzResponse(w http.ResponseWriter) error
+}
+
+type GetPvz200JSONResponse []response.PvzInfo
+
+func (response GetPvz200JSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvz400ApplicationProblemPlusJSONResponse Error
+
+func (response GetPvz400ApplicationProblemPlusJSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvz401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetPvz401ApplicationProblemPlusJSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvz403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetPvz403ApplicationProblemPlusJSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvz500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetPvz500ApplicationProblemPlusJSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzRequestObject struct {
+	Body *PostPvzJSONRequestBody
+}
+
+type PostPvzResponseObject interface {
+	VisitPostPvzResponse(w http.ResponseWriter) error
+}
+
+type PostPvz201ResponseHeaders struct {
+	ETag string
+}
+
+type PostPvz201JSONResponse struct {
+	Body    PVZ
+	Headers PostPvz201ResponseHeaders
+}
+
+func (response PostPvz201JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPvz400ApplicationProblemPlusJSONResponse Error
+
+func (response PostPvz400ApplicationProblemPlusJSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvz401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostPvz401ApplicationProblemPlusJSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvz403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostPvz403ApplicationProblemPlusJSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvz500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostPvz500ApplicationProblemPlusJSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzNearbyRequestObject struct {
+	Params GetPvzNearbyParams
+}
+
+type GetPvzNearbyResponseObject interface {
+	VisitGetPvzNearbyResponse(w http.ResponseWriter) error
+}
+
+type GetPvzNearby200JSONResponse []response.NearbyPvz
+
+func (response GetPvzNearby200JSONResponse) VisitGetPvzNearbyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzNearby400ApplicationProblemPlusJSONResponse Error
+
+func (response GetPvzNearby400ApplicationProblemPlusJSONResponse) VisitGetPvzNearbyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzNearby401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetPvzNearby401ApplicationProblemPlusJSONResponse) VisitGetPvzNearbyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzNearby403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetPvzNearby403ApplicationProblemPlusJSONResponse) VisitGetPvzNearbyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzNearby500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetPvzNearby500ApplicationProblemPlusJSONResponse) VisitGetPvzNearbyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePvzPvzIdRequestObject struct {
+	PvzId  openapi_types.UUID `json:"pvzId"`
+	Params DeletePvzPvzIdParams
+}
+
+type DeletePvzPvzIdResponseObject interface {
+	VisitDeletePvzPvzIdResponse(w http.ResponseWriter) error
+}
+
+type DeletePvzPvzId204Response struct {
+}
+
+func (response DeletePvzPvzId204Response) VisitDeletePvzPvzIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePvzPvzId400ApplicationProblemPlusJSONResponse Error
+
+func (response DeletePvzPvzId400ApplicationProblemPlusJSONResponse) VisitDeletePvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePvzPvzId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeletePvzPvzId401ApplicationProblemPlusJSONResponse) VisitDeletePvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePvzPvzId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeletePvzPvzId403ApplicationProblemPlusJSONResponse) VisitDeletePvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePvzPvzId404ApplicationProblemPlusJSONResponse Error
+
+func (response DeletePvzPvzId404ApplicationProblemPlusJSONResponse) VisitDeletePvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePvzPvzId409ApplicationProblemPlusJSONResponse Error
+
+func (response DeletePvzPvzId409ApplicationProblemPlusJSONResponse) VisitDeletePvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePvzPvzId412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
+}
+
+func (response DeletePvzPvzId412ApplicationProblemPlusJSONResponse) VisitDeletePvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePvzPvzId500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeletePvzPvzId500ApplicationProblemPlusJSONResponse) VisitDeletePvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchPvzPvzIdRequestObject struct {
+	PvzId  openapi_types.UUID `json:"pvzId"`
+	Params PatchPvzPvzIdParams
+	Body   *PatchPvzPvzIdJSONRequestBody
+}
+
+type PatchPvzPvzIdResponseObject interface {
+	VisitPatchPvzPvzIdResponse(w http.ResponseWriter) error
+}
+
+type PatchPvzPvzId200ResponseHeaders struct {
+	ETag string
+}
+
+type PatchPvzPvzId200JSONResponse struct {
+	Body    PVZ
+	Headers PatchPvzPvzId200ResponseHeaders
+}
+
+func (response PatchPvzPvzId200JSONResponse) VisitPatchPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PatchPvzPvzId400ApplicationProblemPlusJSONResponse Error
+
+func (response PatchPvzPvzId400ApplicationProblemPlusJSONResponse) VisitPatchPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchPvzPvzId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PatchPvzPvzId401ApplicationProblemPlusJSONResponse) VisitPatchPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchPvzPvzId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PatchPvzPvzId403ApplicationProblemPlusJSONResponse) VisitPatchPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchPvzPvzId404ApplicationProblemPlusJSONResponse Error
+
+func (response PatchPvzPvzId404ApplicationProblemPlusJSONResponse) VisitPatchPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchPvzPvzId412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
+}
+
+func (response PatchPvzPvzId412ApplicationProblemPlusJSONResponse) VisitPatchPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchPvzPvzId500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PatchPvzPvzId500ApplicationProblemPlusJSONResponse) VisitPatchPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReceptionRequestObject struct {
+	PvzId  openapi_types.UUID `json:"pvzId"`
+	Params PostPvzPvzIdCloseLastReceptionParams
+}
+
+type PostPvzPvzIdCloseLastReceptionResponseObject interface {
+	VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdCloseLastReception200ResponseHeaders struct {
+	ETag string
+}
+
+type PostPvzPvzIdCloseLastReception200JSONResponse struct {
+	Body    Reception
+	Headers PostPvzPvzIdCloseLastReception200ResponseHeaders
+}
+
+func (response PostPvzPvzIdCloseLastReception200JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPvzPvzIdCloseLastReception400ApplicationProblemPlusJSONResponse Error
+
+func (response PostPvzPvzIdCloseLastReception400ApplicationProblemPlusJSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReception401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostPvzPvzIdCloseLastReception401ApplicationProblemPlusJSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReception403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostPvzPvzIdCloseLastReception403ApplicationProblemPlusJSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReception409ApplicationProblemPlusJSONResponse Error
+
+func (response PostPvzPvzIdCloseLastReception409ApplicationProblemPlusJSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReception412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
+}
+
+func (response PostPvzPvzIdCloseLastReception412ApplicationProblemPlusJSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReception500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostPvzPvzIdCloseLastReception500ApplicationProblemPlusJSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastShipmentRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+type PostPvzPvzIdCloseLastShipmentResponseObject interface {
+	VisitPostPvzPvzIdCloseLastShipmentResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdCloseLastShipment200JSONResponse Shipment
+
+func (response PostPvzPvzIdCloseLastShipment200JSONResponse) VisitPostPvzPvzIdCloseLastShipmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastShipment400ApplicationProblemPlusJSONResponse Error
+
+func (response PostPvzPvzIdCloseLastShipment400ApplicationProblemPlusJSONResponse) VisitPostPvzPvzIdCloseLastShipmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastShipment401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostPvzPvzIdCloseLastShipment401ApplicationProblemPlusJSONResponse) VisitPostPvzPvzIdCloseLastShipmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastShipment403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostPvzPvzIdCloseLastShipment403ApplicationProblemPlusJSONResponse) VisitPostPvzPvzIdCloseLastShipmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastShipment409ApplicationProblemPlusJSONResponse Error
+
+func (response PostPvzPvzIdCloseLastShipment409ApplicationProblemPlusJSONResponse) VisitPostPvzPvzIdCloseLastShipmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastShipment500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostPvzPvzIdCloseLastShipment500ApplicationProblemPlusJSONResponse) VisitPostPvzPvzIdCloseLastShipmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdDeleteLastProductRequestObject struct {
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+type PostPvzPvzIdDeleteLastProductResponseObject interface {
+	VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdDeleteLastProduct200Response struct {
+}
+
+func (response PostPvzPvzIdDeleteLastProduct200Response) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostPvzPvzIdDeleteLastProduct400ApplicationProblemPlusJSONResponse Error
+
+func (response PostPvzPvzIdDeleteLastProduct400ApplicationProblemPlusJSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdDeleteLastProduct401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostPvzPvzIdDeleteLastProduct401ApplicationProblemPlusJSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdDeleteLastProduct403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostPvzPvzIdDeleteLastProduct403ApplicationProblemPlusJSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdDeleteLastProduct404ApplicationProblemPlusJSONResponse Error
+
+func (response PostPvzPvzIdDeleteLastProduct404ApplicationProblemPlusJSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdDeleteLastProduct409ApplicationProblemPlusJSONResponse Error
+
+func (response PostPvzPvzIdDeleteLastProduct409ApplicationProblemPlusJSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdDeleteLastProduct500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostPvzPvzIdDeleteLastProduct500ApplicationProblemPlusJSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdProductsRequestObject struct {
+	PvzId  openapi_types.UUID `json:"pvzId"`
+	Params GetPvzPvzIdProductsParams
+}
+
+type GetPvzPvzIdProductsResponseObject interface {
+	VisitGetPvzPvzIdProductsResponse(w http.ResponseWriter) error
+}
+
+type GetPvzPvzIdProducts200JSONResponse []Product
+
+func (response GetPvzPvzIdProducts200JSONResponse) VisitGetPvzPvzIdProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdProducts400ApplicationProblemPlusJSONResponse Error
+
+func (response GetPvzPvzIdProducts400ApplicationProblemPlusJSONResponse) VisitGetPvzPvzIdProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdProducts401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetPvzPvzIdProducts401ApplicationProblemPlusJSONResponse) VisitGetPvzPvzIdProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdProducts403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetPvzPvzIdProducts403ApplicationProblemPlusJSONResponse) VisitGetPvzPvzIdProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdProducts500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetPvzPvzIdProducts500ApplicationProblemPlusJSONResponse) VisitGetPvzPvzIdProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdSettingsRequestObject struct {
+	PvzId  openapi_types.UUID `json:"pvzId"`
+	Params PutPvzPvzIdSettingsParams
+	Body   *PutPvzPvzIdSettingsJSONRequestBody
+}
+
+type PutPvzPvzIdSettingsResponseObject interface {
+	VisitPutPvzPvzIdSettingsResponse(w http.ResponseWriter) error
+}
+
+type PutPvzPvzIdSettings200ResponseHeaders struct {
+	ETag string
+}
+
+type PutPvzPvzIdSettings200JSONResponse struct {
+	Body    PVZ
+	Headers PutPvzPvzIdSettings200ResponseHeaders
+}
+
+func (response PutPvzPvzIdSettings200JSONResponse) VisitPutPvzPvzIdSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PutPvzPvzIdSettings400ApplicationProblemPlusJSONResponse Error
+
+func (response PutPvzPvzIdSettings400ApplicationProblemPlusJSONResponse) VisitPutPvzPvzIdSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdSettings401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PutPvzPvzIdSettings401ApplicationProblemPlusJSONResponse) VisitPutPvzPvzIdSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdSettings403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PutPvzPvzIdSettings403ApplicationProblemPlusJSONResponse) VisitPutPvzPvzIdSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdSettings404ApplicationProblemPlusJSONResponse Error
+
+func (response PutPvzPvzIdSettings404ApplicationProblemPlusJSONResponse) VisitPutPvzPvzIdSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdSettings412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
+}
+
+func (response PutPvzPvzIdSettings412ApplicationProblemPlusJSONResponse) VisitPutPvzPvzIdSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdSettings500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PutPvzPvzIdSettings500ApplicationProblemPlusJSONResponse) VisitPutPvzPvzIdSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsRequestObject struct {
+	Body *PostReceptionsJSONRequestBody
+}
+
+type PostReceptionsResponseObject interface {
+	VisitPostReceptionsResponse(w http.ResponseWriter) error
+}
+
+type PostReceptions201ResponseHeaders struct {
+	ETag string
+}
+
+type PostReceptions201JSONResponse struct {
+	Body    Reception
+	Headers PostReceptions201ResponseHeaders
+}
+
+func (response PostReceptions201JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostReceptions400ApplicationProblemPlusJSONResponse Error
+
+func (response PostReceptions400ApplicationProblemPlusJSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptions401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostReceptions401ApplicationProblemPlusJSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptions403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostReceptions403ApplicationProblemPlusJSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptions404ApplicationProblemPlusJSONResponse Error
+
+func (response PostReceptions404ApplicationProblemPlusJSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptions409ApplicationProblemPlusJSONResponse Error
+
+func (response PostReceptions409ApplicationProblemPlusJSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptions500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostReceptions500ApplicationProblemPlusJSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdCancelRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+	Params      PostReceptionsReceptionIdCancelParams
+	Body        *PostReceptionsReceptionIdCancelJSONRequestBody
+}
+
+type PostReceptionsReceptionIdCancelResponseObject interface {
+	VisitPostReceptionsReceptionIdCancelResponse(w http.ResponseWriter) error
+}
+
+type PostReceptionsReceptionIdCancel200ResponseHeaders struct {
+	ETag string
+}
+
+type PostReceptionsReceptionIdCancel200JSONResponse struct {
+	Body    Reception
+	Headers PostReceptionsReceptionIdCancel200ResponseHeaders
+}
+
+func (response PostReceptionsReceptionIdCancel200JSONResponse) VisitPostReceptionsReceptionIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostReceptionsReceptionIdCancel400ApplicationProblemPlusJSONResponse Error
+
+func (response PostReceptionsReceptionIdCancel400ApplicationProblemPlusJSONResponse) VisitPostReceptionsReceptionIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdCancel401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostReceptionsReceptionIdCancel401ApplicationProblemPlusJSONResponse) VisitPostReceptionsReceptionIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdCancel403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostReceptionsReceptionIdCancel403ApplicationProblemPlusJSONResponse) VisitPostReceptionsReceptionIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdCancel404ApplicationProblemPlusJSONResponse Error
+
+func (response PostReceptionsReceptionIdCancel404ApplicationProblemPlusJSONResponse) VisitPostReceptionsReceptionIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdCancel409ApplicationProblemPlusJSONResponse Error
+
+func (response PostReceptionsReceptionIdCancel409ApplicationProblemPlusJSONResponse) VisitPostReceptionsReceptionIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdCancel412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
+}
+
+func (response PostReceptionsReceptionIdCancel412ApplicationProblemPlusJSONResponse) VisitPostReceptionsReceptionIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdCancel500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostReceptionsReceptionIdCancel500ApplicationProblemPlusJSONResponse) VisitPostReceptionsReceptionIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteReceptionsReceptionIdProductsProductIdRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+	ProductId   openapi_types.UUID `json:"productId"`
+}
+
+type DeleteReceptionsReceptionIdProductsProductIdResponseObject interface {
+	VisitDeleteReceptionsReceptionIdProductsProductIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteReceptionsReceptionIdProductsProductId204Response struct {
+}
+
+func (response DeleteReceptionsReceptionIdProductsProductId204Response) VisitDeleteReceptionsReceptionIdProductsProductIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteReceptionsReceptionIdProductsProductId400ApplicationProblemPlusJSONResponse Error
+
+func (response DeleteReceptionsReceptionIdProductsProductId400ApplicationProblemPlusJSONResponse) VisitDeleteReceptionsReceptionIdProductsProductIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteReceptionsReceptionIdProductsProductId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteReceptionsReceptionIdProductsProductId401ApplicationProblemPlusJSONResponse) VisitDeleteReceptionsReceptionIdProductsProductIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteReceptionsReceptionIdProductsProductId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteReceptionsReceptionIdProductsProductId403ApplicationProblemPlusJSONResponse) VisitDeleteReceptionsReceptionIdProductsProductIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteReceptionsReceptionIdProductsProductId404ApplicationProblemPlusJSONResponse Error
+
+func (response DeleteReceptionsReceptionIdProductsProductId404ApplicationProblemPlusJSONResponse) VisitDeleteReceptionsReceptionIdProductsProductIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteReceptionsReceptionIdProductsProductId409ApplicationProblemPlusJSONResponse Error
+
+func (response DeleteReceptionsReceptionIdProductsProductId409ApplicationProblemPlusJSONResponse) VisitDeleteReceptionsReceptionIdProductsProductIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteReceptionsReceptionIdProductsProductId500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteReceptionsReceptionIdProductsProductId500ApplicationProblemPlusJSONResponse) VisitDeleteReceptionsReceptionIdProductsProductIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopenRequestObject struct {
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+	Params      PostReceptionsReceptionIdReopenParams
+}
+
+type PostReceptionsReceptionIdReopenResponseObject interface {
+	VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error
+}
+
+type PostReceptionsReceptionIdReopen200ResponseHeaders struct {
+	ETag string
+}
+
+type PostReceptionsReceptionIdReopen200JSONResponse struct {
+	Body    Reception
+	Headers PostReceptionsReceptionIdReopen200ResponseHeaders
+}
+
+func (response PostReceptionsReceptionIdReopen200JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostReceptionsReceptionIdReopen400ApplicationProblemPlusJSONResponse Error
+
+func (response PostReceptionsReceptionIdReopen400ApplicationProblemPlusJSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostReceptionsReceptionIdReopen401ApplicationProblemPlusJSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostReceptionsReceptionIdReopen403ApplicationProblemPlusJSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen404ApplicationProblemPlusJSONResponse Error
+
+func (response PostReceptionsReceptionIdReopen404ApplicationProblemPlusJSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen409ApplicationProblemPlusJSONResponse Error
+
+func (response PostReceptionsReceptionIdReopen409ApplicationProblemPlusJSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen412ApplicationProblemPlusJSONResponse struct {
+	PreconditionFailedApplicationProblemPlusJSONResponse
+}
+
+func (response PostReceptionsReceptionIdReopen412ApplicationProblemPlusJSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostReceptionsReceptionIdReopen500ApplicationProblemPlusJSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegisterRequestObject struct {
+	Body *PostRegisterJSONRequestBody
+}
+
+type PostRegisterResponseObject interface {
+	VisitPostRegisterResponse(w http.ResponseWriter) error
+}
+
+type PostRegister201JSONResponse User
+
+func (response PostRegister201JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegister400ApplicationProblemPlusJSONResponse Error
+
+func (response PostRegister400ApplicationProblemPlusJSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegister409ApplicationProblemPlusJSONResponse Error
+
+func (response PostRegister409ApplicationProblemPlusJSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegister500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostRegister500ApplicationProblemPlusJSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsDailyRequestObject struct {
+	Params GetReportsDailyParams
+}
+
+type GetReportsDailyResponseObject interface {
+	VisitGetReportsDailyResponse(w http.ResponseWriter) error
+}
+
+type GetReportsDaily200JSONResponse []DailyReport
+
+func (response GetReportsDaily200JSONResponse) VisitGetReportsDailyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsDaily400ApplicationProblemPlusJSONResponse Error
+
+func (response GetReportsDaily400ApplicationProblemPlusJSONResponse) VisitGetReportsDailyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsDaily401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetReportsDaily401ApplicationProblemPlusJSONResponse) VisitGetReportsDailyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsDaily403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetReportsDaily403ApplicationProblemPlusJSONResponse) VisitGetReportsDailyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsDaily500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetReportsDaily500ApplicationProblemPlusJSONResponse) VisitGetReportsDailyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostShipmentsRequestObject struct {
+	Body *PostShipmentsJSONRequestBody
+}
+
+type PostShipmentsResponseObject interface {
+	VisitPostShipmentsResponse(w http.ResponseWriter) error
+}
+
+type PostShipments201JSONResponse Shipment
+
+func (response PostShipments201JSONResponse) VisitPostShipmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostShipments400ApplicationProblemPlusJSONResponse Error
+
+func (response PostShipments400ApplicationProblemPlusJSONResponse) VisitPostShipmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostShipments401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostShipments401ApplicationProblemPlusJSONResponse) VisitPostShipmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostShipments403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostShipments403ApplicationProblemPlusJSONResponse) VisitPostShipmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostShipments404ApplicationProblemPlusJSONResponse Error
+
+func (response PostShipments404ApplicationProblemPlusJSONResponse) VisitPostShipmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostShipments409ApplicationProblemPlusJSONResponse Error
+
+func (response PostShipments409ApplicationProblemPlusJSONResponse) VisitPostShipmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostShipments500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostShipments500ApplicationProblemPlusJSONResponse) VisitPostShipmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetShipmentsShipmentIdRequestObject struct {
+	ShipmentId openapi_types.UUID `json:"shipmentId"`
+}
+
+type GetShipmentsShipmentIdResponseObject interface {
+	VisitGetShipmentsShipmentIdResponse(w http.ResponseWriter) error
+}
+
+type GetShipmentsShipmentId200JSONResponse response.ShipmentWithProducts
+
+func (response GetShipmentsShipmentId200JSONResponse) VisitGetShipmentsShipmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetShipmentsShipmentId400ApplicationProblemPlusJSONResponse Error
+
+func (response GetShipmentsShipmentId400ApplicationProblemPlusJSONResponse) VisitGetShipmentsShipmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetShipmentsShipmentId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetShipmentsShipmentId401ApplicationProblemPlusJSONResponse) VisitGetShipmentsShipmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetShipmentsShipmentId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetShipmentsShipmentId403ApplicationProblemPlusJSONResponse) VisitGetShipmentsShipmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetShipmentsShipmentId404ApplicationProblemPlusJSONResponse Error
+
+func (response GetShipmentsShipmentId404ApplicationProblemPlusJSONResponse) VisitGetShipmentsShipmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetShipmentsShipmentId500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetShipmentsShipmentId500ApplicationProblemPlusJSONResponse) VisitGetShipmentsShipmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostShipmentsShipmentIdProductsRequestObject struct {
+	ShipmentId openapi_types.UUID `json:"shipmentId"`
+	Body       *PostShipmentsShipmentIdProductsJSONRequestBody
+}
+
+type PostShipmentsShipmentIdProductsResponseObject interface {
+	VisitPostShipmentsShipmentIdProductsResponse(w http.ResponseWriter) error
+}
+
+type PostShipmentsShipmentIdProducts200JSONResponse Product
+
+func (response PostShipmentsShipmentIdProducts200JSONResponse) VisitPostShipmentsShipmentIdProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostShipmentsShipmentIdProducts400ApplicationProblemPlusJSONResponse Error
+
+func (response PostShipmentsShipmentIdProducts400ApplicationProblemPlusJSONResponse) VisitPostShipmentsShipmentIdProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostShipmentsShipmentIdProducts401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response PostShipmentsShipmentIdProducts401ApplicationProblemPlusJSONResponse) VisitPostShipmentsShipmentIdProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostShipmentsShipmentIdProducts403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response PostShipmentsShipmentIdProducts403ApplicationProblemPlusJSONResponse) VisitPostShipmentsShipmentIdProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostShipmentsShipmentIdProducts404ApplicationProblemPlusJSONResponse Error
+
+func (response PostShipmentsShipmentIdProducts404ApplicationProblemPlusJSONResponse) VisitPostShipmentsShipmentIdProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostShipmentsShipmentIdProducts409ApplicationProblemPlusJSONResponse Error
+
+func (response PostShipmentsShipmentIdProducts409ApplicationProblemPlusJSONResponse) VisitPostShipmentsShipmentIdProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostShipmentsShipmentIdProducts500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response PostShipmentsShipmentIdProducts500ApplicationProblemPlusJSONResponse) VisitPostShipmentsShipmentIdProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteShipmentsShipmentIdProductsProductIdRequestObject struct {
+	ShipmentId openapi_types.UUID `json:"shipmentId"`
+	ProductId  openapi_types.UUID `json:"productId"`
+}
+
+type DeleteShipmentsShipmentIdProductsProductIdResponseObject interface {
+	VisitDeleteShipmentsShipmentIdProductsProductIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteShipmentsShipmentIdProductsProductId204Response struct {
+}
+
+func (response DeleteShipmentsShipmentIdProductsProductId204Response) VisitDeleteShipmentsShipmentIdProductsProductIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteShipmentsShipmentIdProductsProductId400ApplicationProblemPlusJSONResponse Error
+
+func (response DeleteShipmentsShipmentIdProductsProductId400ApplicationProblemPlusJSONResponse) VisitDeleteShipmentsShipmentIdProductsProductIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteShipmentsShipmentIdProductsProductId401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteShipmentsShipmentIdProductsProductId401ApplicationProblemPlusJSONResponse) VisitDeleteShipmentsShipmentIdProductsProductIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteShipmentsShipmentIdProductsProductId403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteShipmentsShipmentIdProductsProductId403ApplicationProblemPlusJSONResponse) VisitDeleteShipmentsShipmentIdProductsProductIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteShipmentsShipmentIdProductsProductId404ApplicationProblemPlusJSONResponse Error
+
+func (response DeleteShipmentsShipmentIdProductsProductId404ApplicationProblemPlusJSONResponse) VisitDeleteShipmentsShipmentIdProductsProductIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteShipmentsShipmentIdProductsProductId409ApplicationProblemPlusJSONResponse Error
+
+func (response DeleteShipmentsShipmentIdProductsProductId409ApplicationProblemPlusJSONResponse) VisitDeleteShipmentsShipmentIdProductsProductIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteShipmentsShipmentIdProductsProductId500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response DeleteShipmentsShipmentIdProductsProductId500ApplicationProblemPlusJSONResponse) VisitDeleteShipmentsShipmentIdProductsProductIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsRequestObject struct {
+	Params GetStatsParams
+}
+
+type GetStatsResponseObject interface {
+	VisitGetStatsResponse(w http.ResponseWriter) error
+}
+
+type GetStats200JSONResponse Stats
+
+func (response GetStats200JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStats400ApplicationProblemPlusJSONResponse Error
+
+func (response GetStats400ApplicationProblemPlusJSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStats401ApplicationProblemPlusJSONResponse struct {
+	UnauthorizedApplicationProblemPlusJSONResponse
+}
+
+func (response GetStats401ApplicationProblemPlusJSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStats403ApplicationProblemPlusJSONResponse struct {
+	ForbiddenApplicationProblemPlusJSONResponse
+}
+
+func (response GetStats403ApplicationProblemPlusJSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStats500ApplicationProblemPlusJSONResponse struct {
+	InternalErrorApplicationProblemPlusJSONResponse
+}
+
+func (response GetStats500ApplicationProblemPlusJSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Справочник городов
+	// (GET /cities)
+	GetCities(ctx context.Context, request GetCitiesRequestObject) (GetCitiesResponseObject, error)
+	// Добавление города (только для модераторов)
+	// (POST /cities)
+	PostCities(ctx context.Context, request PostCitiesRequestObject) (PostCitiesResponseObject, error)
+	// Удаление города без ПВЗ (только для модераторов). Город с ПВЗ можно только деактивировать
+	// (DELETE /cities/{name})
+	DeleteCitiesName(ctx context.Context, request DeleteCitiesNameRequestObject) (DeleteCitiesNameResponseObject, error)
+	// Изменение города (только для модераторов). Переименование применяется к ПВЗ города
+	// (PUT /cities/{name})
+	PutCitiesName(ctx context.Context, request PutCitiesNameRequestObject) (PutCitiesNameResponseObject, error)
+	// Получение тестового токена
+	// (POST /dummyLogin)
+	PostDummyLogin(ctx context.Context, request PostDummyLoginRequestObject) (PostDummyLoginResponseObject, error)
+	// Выгрузка приемок с товарами в CSV. Время выгружается в UTC и в часовом поясе ПВЗ
+	// (GET /export/receptions.csv)
+	GetExportReceptionsCsv(ctx context.Context, request GetExportReceptionsCsvRequestObject) (GetExportReceptionsCsvResponseObject, error)
+	// Выгрузка приемок с товарами в XLSX. Время выгружается в UTC и в часовом поясе ПВЗ
+	// (GET /export/receptions.xlsx)
+	GetExportReceptionsXlsx(ctx context.Context, request GetExportReceptionsXlsxRequestObject) (GetExportReceptionsXlsxResponseObject, error)
+	// Авторизация пользователя
+	// (POST /login)
+	PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)
+	// Справочник типов товаров
+	// (GET /product-types)
+	GetProductTypes(ctx context.Context, request GetProductTypesRequestObject) (GetProductTypesResponseObject, error)
+	// Добавление типа товара (только для модераторов)
+	// (POST /product-types)
+	PostProductTypes(ctx context.Context, request PostProductTypesRequestObject) (PostProductTypesResponseObject, error)
+	// Пометка типа товара устаревшим или его восстановление (только для модераторов)
+	// (PATCH /product-types/{name})
+	PatchProductTypesName(ctx context.Context, request PatchProductTypesNameRequestObject) (PatchProductTypesNameResponseObject, error)
+	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
+	// (POST /products)
+	PostProducts(ctx context.Context, request PostProductsRequestObject) (PostProductsResponseObject, error)
+	// Поиск товаров по штрихкоду
+	// (GET /products/barcode/{barcode})
+	GetProductsBarcodeBarcode(ctx context.Context, request GetProductsBarcodeBarcodeRequestObject) (GetProductsBarcodeBarcodeResponseObject, error)
+	// Пакетное добавление товаров в текущую приемку одним запросом (только для сотрудников ПВЗ)
+	// (POST /products/batch)
+	PostProductsBatch(ctx context.Context, request PostProductsBatchRequestObject) (PostProductsBatchResponseObject, error)
+	// Выдача товара получателю (только для сотрудников ПВЗ)
+	// (POST /products/{productId}/issue)
+	PostProductsProductIdIssue(ctx context.Context, request PostProductsProductIdIssueRequestObject) (PostProductsProductIdIssueResponseObject, error)
+	// Возврат товара отправителю (только для сотрудников ПВЗ)
+	// (POST /products/{productId}/return)
+	PostProductsProductIdReturn(ctx context.Context, request PostProductsProductIdReturnRequestObject) (PostProductsProductIdReturnResponseObject, error)
+	// Передача принятого товара на хранение (только для сотрудников ПВЗ)
+	// (POST /products/{productId}/store)
+	PostProductsProductIdStore(ctx context.Context, request PostProductsProductIdStoreRequestObject) (PostProductsProductIdStoreResponseObject, error)
+	// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией
+	// (GET /pvz)
+	GetPvz(ctx context.Context, request GetPvzRequestObject) (GetPvzResponseObject, error)
+	// Создание ПВЗ (только для модераторов)
+	// (POST /pvz)
+	PostPvz(ctx context.Context, request PostPvzRequestObject) (PostPvzResponseObject, error)
+	// Поиск активных ПВЗ рядом с точкой, отсортированных по расстоянию
+	// (GET /pvz/nearby)
+	GetPvzNearby(ctx context.Context, request GetPvzNearbyRequestObject) (GetPvzNearbyResponseObject, error)
+	// Архивация ПВЗ (только для модераторов). История приемок и товаров сохраняется
+	// (DELETE /pvz/{pvzId})
+	DeletePvzPvzId(ctx context.Context, request DeletePvzPvzIdRequestObject) (DeletePvzPvzIdResponseObject, error)
+	// Изменение данных ПВЗ (только для модераторов)
+	// (PATCH /pvz/{pvzId})
+	PatchPvzPvzId(ctx context.Context, request PatchPvzPvzIdRequestObject) (PatchPvzPvzIdResponseObject, error)
+	// Закрытие последней открытой приемки товаров в рамках ПВЗ
+	// (POST /pvz/{pvzId}/close_last_reception)
+	PostPvzPvzIdCloseLastReception(ctx context.Context, request PostPvzPvzIdCloseLastReceptionRequestObject) (PostPvzPvzIdCloseLastReceptionResponseObject, error)
+	// Закрытие открытой отгрузки ПВЗ (только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/close_last_shipment)
+	PostPvzPvzIdCloseLastShipment(ctx context.Context, request PostPvzPvzIdCloseLastShipmentRequestObject) (PostPvzPvzIdCloseLastShipmentResponseObject, error)
+	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/delete_last_product)
+	PostPvzPvzIdDeleteLastProduct(ctx context.Context, request PostPvzPvzIdDeleteLastProductRequestObject) (PostPvzPvzIdDeleteLastProductResponseObject, error)
+	// Товары, находящиеся в ПВЗ (принятые и хранящиеся)
+	// (GET /pvz/{pvzId}/products)
+	GetPvzPvzIdProducts(ctx context.Context, request GetPvzPvzIdProductsRequestObject) (GetPvzPvzIdProductsResponseObject, error)
+	// Настройка ограничений приемок ПВЗ (только для модераторов). Отсутствующее значение снимает ограничение
+	// (PUT /pvz/{pvzId}/settings)
+	PutPvzPvzIdSettings(ctx context.Context, request PutPvzPvzIdSettingsRequestObject) (PutPvzPvzIdSettingsResponseObject, error)
+	// Создание новой приемки товаров (только для сотрудников ПВЗ)
+	// (POST /receptions)
+	PostReceptions(ctx context.Context, request PostReceptionsRequestObject) (PostReceptionsResponseObject, error)
+	// Отмена незакрытой приемки (только для модераторов). Товары сохраняются
+	// (POST /receptions/{receptionId}/cancel)
+	PostReceptionsReceptionIdCancel(ctx context.Context, request PostReceptionsReceptionIdCancelRequestObject) (PostReceptionsReceptionIdCancelResponseObject, error)
+	// Удаление товара из незакрытой приемки по id (только для сотрудников ПВЗ)
+	// (DELETE /receptions/{receptionId}/products/{productId})
+	DeleteReceptionsReceptionIdProductsProductId(ctx context.Context, request DeleteReceptionsReceptionIdProductsProductIdRequestObject) (DeleteReceptionsReceptionIdProductsProductIdResponseObject, error)
+	// Повторное открытие последней закрытой приемки (только для модераторов)
+	// (POST /receptions/{receptionId}/reopen)
+	PostReceptionsReceptionIdReopen(ctx context.Context, request PostReceptionsReceptionIdReopenRequestObject) (PostReceptionsReceptionIdReopenResponseObject, error)
+	// Регистрация пользователя
+	// (POST /register)
+	PostRegister(ctx context.Context, request PostRegisterRequestObject) (PostRegisterResponseObject, error)
+	// Ежедневная сводка по приемкам и товарам в разрезе ПВЗ
+	// (GET /reports/daily)
+	GetReportsDaily(ctx context.Context, request GetReportsDailyRequestObject) (GetReportsDailyResponseObject, error)
+	// Открытие отгрузки невостребованных товаров (только для сотрудников ПВЗ)
+	// (POST /shipments)
+	PostShipments(ctx context.Context, request PostShipmentsRequestObject) (PostShipmentsResponseObject, error)
+	// Отгрузка и прикрепленные к ней товары
+	// (GET /shipments/{shipmentId})
+	GetShipmentsShipmentId(ctx context.Context, request GetShipmentsShipmentIdRequestObject) (GetShipmentsShipmentIdResponseObject, error)
+	// Добавление хранящегося товара в открытую отгрузку (только для сотрудников ПВЗ)
+	// (POST /shipments/{shipmentId}/products)
+	PostShipmentsShipmentIdProducts(ctx context.Context, request PostShipmentsShipmentIdProductsRequestObject) (PostShipmentsShipmentIdProductsResponseObject, error)
+	// Удаление товара из открытой отгрузки (только для сотрудников ПВЗ)
+	// (DELETE /shipments/{shipmentId}/products/{productId})
+	DeleteShipmentsShipmentIdProductsProductId(ctx context.Context, request DeleteShipmentsShipmentIdProductsProductIdRequestObject) (DeleteShipmentsShipmentIdProductsProductIdResponseObject, error)
+	// Статистика по приемкам и товарам за период
+	// (GET /stats)
+	GetStats(ctx context.Context, request GetStatsRequestObject) (GetStatsResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetCities operation middleware
+func (sh *strictHandler) GetCities(ctx *gin.Context, params GetCitiesParams) {
+	var request GetCitiesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCities(ctx, request.(GetCitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCities")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetCitiesResponseObject); ok {
+		if err := validResponse.VisitGetCitiesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostCities operation middleware
+func (sh *strictHandler) PostCities(ctx *gin.Context) {
+	var request PostCitiesRequestObject
+
+	var body PostCitiesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCities(ctx, request.(PostCitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCities")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostCitiesResponseObject); ok {
+		if err := validResponse.VisitPostCitiesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteCitiesName operation middleware
+func (sh *strictHandler) DeleteCitiesName(ctx *gin.Context, name string) {
+	var request DeleteCitiesNameRequestObject
+
+	request.Name = name
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCitiesName(ctx, request.(DeleteCitiesNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCitiesName")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteCitiesNameResponseObject); ok {
+		if err := validResponse.VisitDeleteCitiesNameResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutCitiesName operation middleware
+func (sh *strictHandler) PutCitiesName(ctx *gin.Context, name string) {
+	var request PutCitiesNameRequestObject
+
+	request.Name = name
+
+	var body PutCitiesNameJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutCitiesName(ctx, request.(PutCitiesNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutCitiesName")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutCitiesNameResponseObject); ok {
+		if err := validResponse.VisitPutCitiesNameResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostDummyLogin operation middleware
+func (sh *strictHandler) PostDummyLogin(ctx *gin.Context) {
+	var request PostDummyLoginRequestObject
+
+	var body PostDummyLoginJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostDummyLogin(ctx, request.(PostDummyLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostDummyLogin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostDummyLoginResponseObject); ok {
+		if err := validResponse.VisitPostDummyLoginResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetExportReceptionsCsv operation middleware
+func (sh *strictHandler) GetExportReceptionsCsv(ctx *gin.Context, params GetExportReceptionsCsvParams) {
+	var request GetExportReceptionsCsvRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetExportReceptionsCsv(ctx, request.(GetExportReceptionsCsvRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetExportReceptionsCsv")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetExportReceptionsCsvResponseObject); ok {
+		if err := validResponse.VisitGetExportReceptionsCsvResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetExportReceptionsXlsx operation middleware
+func (sh *strictHandler) GetExportReceptionsXlsx(ctx *gin.Context, params GetExportReceptionsXlsxParams) {
+	var request GetExportReceptionsXlsxRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetExportReceptionsXlsx(ctx, request.(GetExportReceptionsXlsxRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetExportReceptionsXlsx")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetExportReceptionsXlsxResponseObject); ok {
+		if err := validResponse.VisitGetExportReceptionsXlsxResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostLogin operation middleware
+func (sh *strictHandler) PostLogin(ctx *gin.Context) {
+	var request PostLoginRequestObject
+
+	var body PostLoginJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLogin(ctx, request.(PostLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLogin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostLoginResponseObject); ok {
+		if err := validResponse.VisitPostLoginResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProductTypes operation middleware
+func (sh *strictHandler) GetProductTypes(ctx *gin.Context, params GetProductTypesParams) {
+	var request GetProductTypesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProductTypes(ctx, request.(GetProductTypesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProductTypes")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetProductTypesResponseObject); ok {
+		if err := validResponse.VisitGetProductTypesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostProductTypes operation middleware
+func (sh *strictHandler) PostProductTypes(ctx *gin.Context) {
+	var request PostProductTypesRequestObject
+
+	var body PostProductTypesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductTypes(ctx, request.(PostProductTypesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductTypes")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostProductTypesResponseObject); ok {
+		if err := validResponse.VisitPostProductTypesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchProductTypesName operation middleware
+func (sh *strictHandler) PatchProductTypesName(ctx *gin.Context, name string) {
+	var request PatchProductTypesNameRequestObject
+
+	request.Name = name
+
+	var body PatchProductTypesNameJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchProductTypesName(ctx, request.(PatchProductTypesNameRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchProductTypesName")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PatchProductTypesNameResponseObject); ok {
+		if err := validResponse.VisitPatchProductTypesNameResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostProducts operation middleware
+func (sh *strictHandler) PostProducts(ctx *gin.Context) {
+	var request PostProductsRequestObject
+
+	var body PostProductsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProducts(ctx, request.(PostProductsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProducts")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostProductsResponseObject); ok {
+		if err := validResponse.VisitPostProductsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProductsBarcodeBarcode operation middleware
+func (sh *strictHandler) GetProductsBarcodeBarcode(ctx *gin.Context, barcode Barcode) {
+	var request GetProductsBarcodeBarcodeRequestObject
+
+	request.Barcode = barcode
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProductsBarcodeBarcode(ctx, request.(GetProductsBarcodeBarcodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProductsBarcodeBarcode")
+	}
+
+	response, err := handler(ctx, request)
 
-type PostReceptionsRequestObject struct {
-	Body *PostReceptionsJSONRequestBody
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetProductsBarcodeBarcodeResponseObject); ok {
+		if err := validResponse.VisitGetProductsBarcodeBarcodeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostReceptionsResponseObject interface {
-	VisitPostReceptionsResponse(w http.ResponseWriter) error
+// PostProductsBatch operation middleware
+func (sh *strictHandler) PostProductsBatch(ctx *gin.Context) {
+	var request PostProductsBatchRequestObject
+
+	var body PostProductsBatchJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductsBatch(ctx, request.(PostProductsBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductsBatch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostProductsBatchResponseObject); ok {
+		if err := validResponse.VisitPostProductsBatchResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostReceptions201JSONResponse Reception
+// PostProductsProductIdIssue operation middleware
+func (sh *strictHandler) PostProductsProductIdIssue(ctx *gin.Context, productId openapi_types.UUID) {
+	var request PostProductsProductIdIssueRequestObject
 
-func (response PostReceptions201JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	request.ProductId = productId
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductsProductIdIssue(ctx, request.(PostProductsProductIdIssueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductsProductIdIssue")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostProductsProductIdIssueResponseObject); ok {
+		if err := validResponse.VisitPostProductsProductIdIssueResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostReceptions400JSONResponse Error
+// PostProductsProductIdReturn operation middleware
+func (sh *strictHandler) PostProductsProductIdReturn(ctx *gin.Context, productId openapi_types.UUID) {
+	var request PostProductsProductIdReturnRequestObject
 
-func (response PostReceptions400JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.ProductId = productId
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductsProductIdReturn(ctx, request.(PostProductsProductIdReturnRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductsProductIdReturn")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostProductsProductIdReturnResponseObject); ok {
+		if err := validResponse.VisitPostProductsProductIdReturnResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostReceptions403JSONResponse Error
+// PostProductsProductIdStore operation middleware
+func (sh *strictHandler) PostProductsProductIdStore(ctx *gin.Context, productId openapi_types.UUID) {
+	var request PostProductsProductIdStoreRequestObject
 
-func (response PostReceptions403JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.ProductId = productId
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductsProductIdStore(ctx, request.(PostProductsProductIdStoreRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductsProductIdStore")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostProductsProductIdStoreResponseObject); ok {
+		if err := validResponse.VisitPostProductsProductIdStoreResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPvz operation middleware
+func (sh *strictHandler) GetPvz(ctx *gin.Context, params GetPvzParams) {
+	var request GetPvzRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPvz(ctx, request.(GetPvzRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPvz")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetPvzResponseObject); ok {
+		if err := validResponse.VisitGetPvzResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPvz operation middleware
+func (sh *strictHandler) PostPvz(ctx *gin.Context) {
+	var request PostPvzRequestObject
+
+	var body PostPvzJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvz(ctx, request.(PostPvzRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvz")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPvzResponseObject); ok {
+		if err := validResponse.VisitPostPvzResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPvzNearby operation middleware
+func (sh *strictHandler) GetPvzNearby(ctx *gin.Context, params GetPvzNearbyParams) {
+	var request GetPvzNearbyRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPvzNearby(ctx, request.(GetPvzNearbyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPvzNearby")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetPvzNearbyResponseObject); ok {
+		if err := validResponse.VisitGetPvzNearbyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeletePvzPvzId operation middleware
+func (sh *strictHandler) DeletePvzPvzId(ctx *gin.Context, pvzId openapi_types.UUID, params DeletePvzPvzIdParams) {
+	var request DeletePvzPvzIdRequestObject
+
+	request.PvzId = pvzId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePvzPvzId(ctx, request.(DeletePvzPvzIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePvzPvzId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeletePvzPvzIdResponseObject); ok {
+		if err := validResponse.VisitDeletePvzPvzIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchPvzPvzId operation middleware
+func (sh *strictHandler) PatchPvzPvzId(ctx *gin.Context, pvzId openapi_types.UUID, params PatchPvzPvzIdParams) {
+	var request PatchPvzPvzIdRequestObject
+
+	request.PvzId = pvzId
+	request.Params = params
+
+	var body PatchPvzPvzIdJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchPvzPvzId(ctx, request.(PatchPvzPvzIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchPvzPvzId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PatchPvzPvzIdResponseObject); ok {
+		if err := validResponse.VisitPatchPvzPvzIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPvzPvzIdCloseLastReception operation middleware
+func (sh *strictHandler) PostPvzPvzIdCloseLastReception(ctx *gin.Context, pvzId openapi_types.UUID, params PostPvzPvzIdCloseLastReceptionParams) {
+	var request PostPvzPvzIdCloseLastReceptionRequestObject
+
+	request.PvzId = pvzId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdCloseLastReception(ctx, request.(PostPvzPvzIdCloseLastReceptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdCloseLastReception")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPvzPvzIdCloseLastReceptionResponseObject); ok {
+		if err := validResponse.VisitPostPvzPvzIdCloseLastReceptionResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostRegisterRequestObject struct {
-	Body *PostRegisterJSONRequestBody
+// PostPvzPvzIdCloseLastShipment operation middleware
+func (sh *strictHandler) PostPvzPvzIdCloseLastShipment(ctx *gin.Context, pvzId openapi_types.UUID) {
+	var request PostPvzPvzIdCloseLastShipmentRequestObject
+
+	request.PvzId = pvzId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdCloseLastShipment(ctx, request.(PostPvzPvzIdCloseLastShipmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdCloseLastShipment")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPvzPvzIdCloseLastShipmentResponseObject); ok {
+		if err := validResponse.VisitPostPvzPvzIdCloseLastShipmentResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostRegisterResponseObject interface {
-	VisitPostRegisterResponse(w http.ResponseWriter) error
-}
+// PostPvzPvzIdDeleteLastProduct operation middleware
+func (sh *strictHandler) PostPvzPvzIdDeleteLastProduct(ctx *gin.Context, pvzId openapi_types.UUID) {
+	var request PostPvzPvzIdDeleteLastProductRequestObject
 
-type PostRegister201JSONResponse User
+	request.PvzId = pvzId
 
-func (response PostRegister201JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdDeleteLastProduct(ctx, request.(PostPvzPvzIdDeleteLastProductRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdDeleteLastProduct")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(ctx, request)
 
-type PostRegister400JSONResponse Error
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPvzPvzIdDeleteLastProductResponseObject); ok {
+		if err := validResponse.VisitPostPvzPvzIdDeleteLastProductResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response PostRegister400JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetPvzPvzIdProducts operation middleware
+func (sh *strictHandler) GetPvzPvzIdProducts(ctx *gin.Context, pvzId openapi_types.UUID, params GetPvzPvzIdProductsParams) {
+	var request GetPvzPvzIdProductsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.PvzId = pvzId
+	request.Params = params
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Получение тестового токена
-	// (POST /dummyLogin)
-	PostDummyLogin(ctx context.Context, request PostDummyLoginRequestObject) (PostDummyLoginResponseObject, error)
-	// Авторизация пользователя
-	// (POST /login)
-	PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)
-	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
-	// (POST /products)
-	PostProducts(ctx context.Context, request PostProductsRequestObject) (PostProductsResponseObject, error)
-	// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией
-	// (GET /pvz)
-	GetPvz(ctx context.Context, request GetPvzRequestObject) (GetPvzResponseObject, error)
-	// Создание ПВЗ (только для модераторов)
-	// (POST /pvz)
-	PostPvz(ctx context.Context, request PostPvzRequestObject) (PostPvzResponseObject, error)
-	// Закрытие последней открытой приемки товаров в рамках ПВЗ
-	// (POST /pvz/{pvzId}/close_last_reception)
-	PostPvzPvzIdCloseLastReception(ctx context.Context, request PostPvzPvzIdCloseLastReceptionRequestObject) (PostPvzPvzIdCloseLastReceptionResponseObject, error)
-	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
-	// (POST /pvz/{pvzId}/delete_last_product)
-	PostPvzPvzIdDeleteLastProduct(ctx context.Context, request PostPvzPvzIdDeleteLastProductRequestObject) (PostPvzPvzIdDeleteLastProductResponseObject, error)
-	// Создание новой приемки товаров (только для сотрудников ПВЗ)
-	// (POST /receptions)
-	PostReceptions(ctx context.Context, request PostReceptionsRequestObject) (PostReceptionsResponseObject, error)
-	// Регистрация пользователя
-	// (POST /register)
-	PostRegister(ctx context.Context, request PostRegisterRequestObject) (PostRegisterResponseObject, error)
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPvzPvzIdProducts(ctx, request.(GetPvzPvzIdProductsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPvzPvzIdProducts")
+	}
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+	response, err := handler(ctx, request)
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetPvzPvzIdProductsResponseObject); ok {
+		if err := validResponse.VisitGetPvzPvzIdProductsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-}
+// PutPvzPvzIdSettings operation middleware
+func (sh *strictHandler) PutPvzPvzIdSettings(ctx *gin.Context, pvzId openapi_types.UUID, params PutPvzPvzIdSettingsParams) {
+	var request PutPvzPvzIdSettingsRequestObject
 
-// PostDummyLogin operation middleware
-func (sh *strictHandler) PostDummyLogin(ctx *gin.Context) {
-	var request PostDummyLoginRequestObject
+	request.PvzId = pvzId
+	request.Params = params
 
-	var body PostDummyLoginJSONRequestBody
+	var body PutPvzPvzIdSettingsJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -790,10 +5557,10 @@ func (sh *strictHandler) PostDummyLogin(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostDummyLogin(ctx, request.(PostDummyLoginRequestObject))
+		return sh.ssi.PutPvzPvzIdSettings(ctx, request.(PutPvzPvzIdSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostDummyLogin")
+		handler = middleware(handler, "PutPvzPvzIdSettings")
 	}
 
 	response, err := handler(ctx, request)
@@ -801,8 +5568,8 @@ func (sh *strictHandler) PostDummyLogin(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostDummyLoginResponseObject); ok {
-		if err := validResponse.VisitPostDummyLoginResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PutPvzPvzIdSettingsResponseObject); ok {
+		if err := validResponse.VisitPutPvzPvzIdSettingsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -810,11 +5577,11 @@ func (sh *strictHandler) PostDummyLogin(ctx *gin.Context) {
 	}
 }
 
-// PostLogin operation middleware
-func (sh *strictHandler) PostLogin(ctx *gin.Context) {
-	var request PostLoginRequestObject
+// PostReceptions operation middleware
+func (sh *strictHandler) PostReceptions(ctx *gin.Context) {
+	var request PostReceptionsRequestObject
 
-	var body PostLoginJSONRequestBody
+	var body PostReceptionsJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -823,10 +5590,10 @@ func (sh *strictHandler) PostLogin(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostLogin(ctx, request.(PostLoginRequestObject))
+		return sh.ssi.PostReceptions(ctx, request.(PostReceptionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostLogin")
+		handler = middleware(handler, "PostReceptions")
 	}
 
 	response, err := handler(ctx, request)
@@ -834,8 +5601,8 @@ func (sh *strictHandler) PostLogin(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostLoginResponseObject); ok {
-		if err := validResponse.VisitPostLoginResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostReceptionsResponseObject); ok {
+		if err := validResponse.VisitPostReceptionsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -843,11 +5610,14 @@ func (sh *strictHandler) PostLogin(ctx *gin.Context) {
 	}
 }
 
-// PostProducts operation middleware
-func (sh *strictHandler) PostProducts(ctx *gin.Context) {
-	var request PostProductsRequestObject
+// PostReceptionsReceptionIdCancel operation middleware
+func (sh *strictHandler) PostReceptionsReceptionIdCancel(ctx *gin.Context, receptionId openapi_types.UUID, params PostReceptionsReceptionIdCancelParams) {
+	var request PostReceptionsReceptionIdCancelRequestObject
 
-	var body PostProductsJSONRequestBody
+	request.ReceptionId = receptionId
+	request.Params = params
+
+	var body PostReceptionsReceptionIdCancelJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -856,10 +5626,10 @@ func (sh *strictHandler) PostProducts(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProducts(ctx, request.(PostProductsRequestObject))
+		return sh.ssi.PostReceptionsReceptionIdCancel(ctx, request.(PostReceptionsReceptionIdCancelRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProducts")
+		handler = middleware(handler, "PostReceptionsReceptionIdCancel")
 	}
 
 	response, err := handler(ctx, request)
@@ -867,8 +5637,8 @@ func (sh *strictHandler) PostProducts(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostProductsResponseObject); ok {
-		if err := validResponse.VisitPostProductsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostReceptionsReceptionIdCancelResponseObject); ok {
+		if err := validResponse.VisitPostReceptionsReceptionIdCancelResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -876,17 +5646,46 @@ func (sh *strictHandler) PostProducts(ctx *gin.Context) {
 	}
 }
 
-// GetPvz operation middleware
-func (sh *strictHandler) GetPvz(ctx *gin.Context, params GetPvzParams) {
-	var request GetPvzRequestObject
+// DeleteReceptionsReceptionIdProductsProductId operation middleware
+func (sh *strictHandler) DeleteReceptionsReceptionIdProductsProductId(ctx *gin.Context, receptionId openapi_types.UUID, productId openapi_types.UUID) {
+	var request DeleteReceptionsReceptionIdProductsProductIdRequestObject
+
+	request.ReceptionId = receptionId
+	request.ProductId = productId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteReceptionsReceptionIdProductsProductId(ctx, request.(DeleteReceptionsReceptionIdProductsProductIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteReceptionsReceptionIdProductsProductId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteReceptionsReceptionIdProductsProductIdResponseObject); ok {
+		if err := validResponse.VisitDeleteReceptionsReceptionIdProductsProductIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostReceptionsReceptionIdReopen operation middleware
+func (sh *strictHandler) PostReceptionsReceptionIdReopen(ctx *gin.Context, receptionId openapi_types.UUID, params PostReceptionsReceptionIdReopenParams) {
+	var request PostReceptionsReceptionIdReopenRequestObject
 
+	request.ReceptionId = receptionId
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPvz(ctx, request.(GetPvzRequestObject))
+		return sh.ssi.PostReceptionsReceptionIdReopen(ctx, request.(PostReceptionsReceptionIdReopenRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPvz")
+		handler = middleware(handler, "PostReceptionsReceptionIdReopen")
 	}
 
 	response, err := handler(ctx, request)
@@ -894,8 +5693,8 @@ func (sh *strictHandler) GetPvz(ctx *gin.Context, params GetPvzParams) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetPvzResponseObject); ok {
-		if err := validResponse.VisitGetPvzResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostReceptionsReceptionIdReopenResponseObject); ok {
+		if err := validResponse.VisitPostReceptionsReceptionIdReopenResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -903,11 +5702,11 @@ func (sh *strictHandler) GetPvz(ctx *gin.Context, params GetPvzParams) {
 	}
 }
 
-// PostPvz operation middleware
-func (sh *strictHandler) PostPvz(ctx *gin.Context) {
-	var request PostPvzRequestObject
+// PostRegister operation middleware
+func (sh *strictHandler) PostRegister(ctx *gin.Context) {
+	var request PostRegisterRequestObject
 
-	var body PostPvzJSONRequestBody
+	var body PostRegisterJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -916,10 +5715,10 @@ func (sh *strictHandler) PostPvz(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPvz(ctx, request.(PostPvzRequestObject))
+		return sh.ssi.PostRegister(ctx, request.(PostRegisterRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPvz")
+		handler = middleware(handler, "PostRegister")
 	}
 
 	response, err := handler(ctx, request)
@@ -927,8 +5726,8 @@ func (sh *strictHandler) PostPvz(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostPvzResponseObject); ok {
-		if err := validResponse.VisitPostPvzResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostRegisterResponseObject); ok {
+		if err := validResponse.VisitPostRegisterResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -936,17 +5735,17 @@ func (sh *strictHandler) PostPvz(ctx *gin.Context) {
 	}
 }
 
-// PostPvzPvzIdCloseLastReception operation middleware
-func (sh *strictHandler) PostPvzPvzIdCloseLastReception(ctx *gin.Context, pvzId openapi_types.UUID) {
-	var request PostPvzPvzIdCloseLastReceptionRequestObject
+// GetReportsDaily operation middleware
+func (sh *strictHandler) GetReportsDaily(ctx *gin.Context, params GetReportsDailyParams) {
+	var request GetReportsDailyRequestObject
 
-	request.PvzId = pvzId
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPvzPvzIdCloseLastReception(ctx, request.(PostPvzPvzIdCloseLastReceptionRequestObject))
+		return sh.ssi.GetReportsDaily(ctx, request.(GetReportsDailyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPvzPvzIdCloseLastReception")
+		handler = middleware(handler, "GetReportsDaily")
 	}
 
 	response, err := handler(ctx, request)
@@ -954,8 +5753,8 @@ func (sh *strictHandler) PostPvzPvzIdCloseLastReception(ctx *gin.Context, pvzId
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostPvzPvzIdCloseLastReceptionResponseObject); ok {
-		if err := validResponse.VisitPostPvzPvzIdCloseLastReceptionResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetReportsDailyResponseObject); ok {
+		if err := validResponse.VisitGetReportsDailyResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -963,17 +5762,23 @@ func (sh *strictHandler) PostPvzPvzIdCloseLastReception(ctx *gin.Context, pvzId
 	}
 }
 
-// PostPvzPvzIdDeleteLastProduct operation middleware
-func (sh *strictHandler) PostPvzPvzIdDeleteLastProduct(ctx *gin.Context, pvzId openapi_types.UUID) {
-	var request PostPvzPvzIdDeleteLastProductRequestObject
+// PostShipments operation middleware
+func (sh *strictHandler) PostShipments(ctx *gin.Context) {
+	var request PostShipmentsRequestObject
 
-	request.PvzId = pvzId
+	var body PostShipmentsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPvzPvzIdDeleteLastProduct(ctx, request.(PostPvzPvzIdDeleteLastProductRequestObject))
+		return sh.ssi.PostShipments(ctx, request.(PostShipmentsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPvzPvzIdDeleteLastProduct")
+		handler = middleware(handler, "PostShipments")
 	}
 
 	response, err := handler(ctx, request)
@@ -981,8 +5786,8 @@ func (sh *strictHandler) PostPvzPvzIdDeleteLastProduct(ctx *gin.Context, pvzId o
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostPvzPvzIdDeleteLastProductResponseObject); ok {
-		if err := validResponse.VisitPostPvzPvzIdDeleteLastProductResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostShipmentsResponseObject); ok {
+		if err := validResponse.VisitPostShipmentsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -990,11 +5795,40 @@ func (sh *strictHandler) PostPvzPvzIdDeleteLastProduct(ctx *gin.Context, pvzId o
 	}
 }
 
-// PostReceptions operation middleware
-func (sh *strictHandler) PostReceptions(ctx *gin.Context) {
-	var request PostReceptionsRequestObject
+// GetShipmentsShipmentId operation middleware
+func (sh *strictHandler) GetShipmentsShipmentId(ctx *gin.Context, shipmentId openapi_types.UUID) {
+	var request GetShipmentsShipmentIdRequestObject
 
-	var body PostReceptionsJSONRequestBody
+	request.ShipmentId = shipmentId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetShipmentsShipmentId(ctx, request.(GetShipmentsShipmentIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetShipmentsShipmentId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetShipmentsShipmentIdResponseObject); ok {
+		if err := validResponse.VisitGetShipmentsShipmentIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostShipmentsShipmentIdProducts operation middleware
+func (sh *strictHandler) PostShipmentsShipmentIdProducts(ctx *gin.Context, shipmentId openapi_types.UUID) {
+	var request PostShipmentsShipmentIdProductsRequestObject
+
+	request.ShipmentId = shipmentId
+
+	var body PostShipmentsShipmentIdProductsJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -1003,10 +5837,10 @@ func (sh *strictHandler) PostReceptions(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostReceptions(ctx, request.(PostReceptionsRequestObject))
+		return sh.ssi.PostShipmentsShipmentIdProducts(ctx, request.(PostShipmentsShipmentIdProductsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostReceptions")
+		handler = middleware(handler, "PostShipmentsShipmentIdProducts")
 	}
 
 	response, err := handler(ctx, request)
@@ -1014,8 +5848,8 @@ func (sh *strictHandler) PostReceptions(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostReceptionsResponseObject); ok {
-		if err := validResponse.VisitPostReceptionsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostShipmentsShipmentIdProductsResponseObject); ok {
+		if err := validResponse.VisitPostShipmentsShipmentIdProductsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1023,23 +5857,45 @@ func (sh *strictHandler) PostReceptions(ctx *gin.Context) {
 	}
 }
 
-// PostRegister operation middleware
-func (sh *strictHandler) PostRegister(ctx *gin.Context) {
-	var request PostRegisterRequestObject
+// DeleteShipmentsShipmentIdProductsProductId operation middleware
+func (sh *strictHandler) DeleteShipmentsShipmentIdProductsProductId(ctx *gin.Context, shipmentId openapi_types.UUID, productId openapi_types.UUID) {
+	var request DeleteShipmentsShipmentIdProductsProductIdRequestObject
 
-	var body PostRegisterJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
+	request.ShipmentId = shipmentId
+	request.ProductId = productId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteShipmentsShipmentIdProductsProductId(ctx, request.(DeleteShipmentsShipmentIdProductsProductIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteShipmentsShipmentIdProductsProductId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
 		ctx.Error(err)
-		return
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteShipmentsShipmentIdProductsProductIdResponseObject); ok {
+		if err := validResponse.VisitDeleteShipmentsShipmentIdProductsProductIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
-	request.Body = &body
+}
+
+// GetStats operation middleware
+func (sh *strictHandler) GetStats(ctx *gin.Context, params GetStatsParams) {
+	var request GetStatsRequestObject
+
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostRegister(ctx, request.(PostRegisterRequestObject))
+		return sh.ssi.GetStats(ctx, request.(GetStatsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostRegister")
+		handler = middleware(handler, "GetStats")
 	}
 
 	response, err := handler(ctx, request)
@@ -1047,8 +5903,8 @@ func (sh *strictHandler) PostRegister(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostRegisterResponseObject); ok {
-		if err := validResponse.VisitPostRegisterResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetStatsResponseObject); ok {
+		if err := validResponse.VisitGetStatsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1059,34 +5915,157 @@ func (sh *strictHandler) PostRegister(ctx *gin.Context) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xZ724TSRJ/lVHffeCkASfHffK3u+M4cUK6iONYCRShwe44A54/9LSza5Cl2N6FRWSX",
-	"1QoJCS1iWV5gcGJinHjyCtVvtKrqGXvGHsdOYmUNn2zPVHdXVf+q6lflx6zkOb7nclcGrPiYBaVN7lj0",
-	"9V9CeAK/+MLzuZA2p8cODwKrwvGrrPucFVkghe1WWKNhMsEf1mzBy6x4Zyi4biaC3r37vCRZw2Rrt25P",
-	"7lyyZR0/uVtzcAP4BSLVhD50IGQmg3cQwgD6qnUR3kJXtaCrtuGDaqtt2MX3ryGEfZRRO6lDE+1MZpdx",
-	"9w1POJZkRVar2WWWIyZ4xQ6ksKTtuVcsyTOLypbkF6Xt8MmVY+aTNbm2C69cK8lJ+3Hvm7j1nAeewKIS",
-	"99Gca/PJ6weji1A/wAF00fNqGyIYQA/6+koi2IMufIS95OcH1YZOrv/H3ENvs6rlOetG8v4c3eVvPZrT",
-	"UYG0ZC1Iu8p27/rCqwgeBMxkpaoX8Nm+GFqSnD3cOc8lN70H3M0JP5P9P+A5Acsdy65mzNFPzoAnr5rB",
-	"B3f8qlfnqL/jlbmwpCdmW51oQbtNGoru5aWasGX9f5iUtDH3uCW4+HtNbo5+XU30/c9XN9F1JM2K8duR",
-	"AZtS+qyBG9vuhkcQ4kFJ2DG+MMFgRulATzUN2IMD9cJQbThS2xBCh0JgAD31woC38DO8MqBn0MsedOEQ",
-	"+hDBJ0O1IMJ8RYHSwbNtWSVlrNID7paNgIstu4Su2uIi0AevXlq5tIKO9XzuWr7NiuwyPTKZb8lNMrxQ",
-	"rjlO/bpXsXUoeAFlELxoKwlttuYF8spITvubB/IfXpkya8lzJXdpoeX7VbtESwv3Ax1fOvlPImgx9z3t",
-	"njNiUtQ4PQh8zw308X9dWTmR8n8WfIMV2Z8Ko9JWiOtaQQcPHTp2+e9VE46gq76HAYR4ySF08Dbpgvch",
-	"VE/w7vGW/rZAfXSRzdPnDXShQ4AcqOfwyaDShnCLVFNHR81xLFFH2bcQwYFqq6caotA1qDo2YzRGsAuR",
-	"hmafJELaoFCdjabFAukEqci3guBrT5Rn84xki+GKLwNjq+eOsa6hIaRa8U8s6zDQP8Yh91Oe5gYcERJ3",
-	"YD9Ogy3oYh7VePM18wmOh9xaIrUo1M1fz8+R+GilTgfVxUEj4aJ54PgtqWSIgwg+jIrgciRBA3pwgDV4",
-	"gJDFWOqrFvSgAwMqxZna3NM6Xz4HnV+icqqFzGGkb1c9055L0RpWvJMlNHfWG+uZIHuZ9XuS2ROGERrQ",
-	"oUwPfdVWz1Rb/ZixWrWNCySOEdmHaEhqmhAhpFUb9mJQR9CJac1f4ljdeoQuqPCcKP03l2tbjyjlCsvh",
-	"kouAbJm4vFA9hZBOj/PdHqWEEL/00DPUsUVUkZCVsSJ7WOOizkzmWo4OIktI6sLM1MXM145NKPSajuqq",
-	"p6dWh7vlRSnzBiI4RGgbhJZtSrU99UQ9n3K2j810+uAy37BqVcmKqyZzbNd2MGmtDs+2XckrXEz1xAH0",
-	"KNsjS+ggP9DJ7hCRpkGGoRWOqQfdKepVbceWU/RbMZljfaMVvLwyQ9v1M5ZmW3InyK0CM7PhrduZdjk4",
-	"brtULRuKzJVqhyZbQlj1zIGz9hh1w41GTmeY3XcOicnk9Q6OsP1BphjngxOmrBwq2oz37GOk6dZJNQ31",
-	"LeZvtaPBhfwBupS1dZ4i6jCWw3XHBSHsQo9COF5EfHE6n6BUdVoqMRMv51ywkyPH7i1xK0Swr2nbsnQq",
-	"n2HZfTfyIiE49m5uLYVDzQUJxJoQR9AZFdHCY2J6jQLNgu5WrUDezcT7scBdw7X/xJXXrUCOwn+i9FJG",
-	"9i25maoX8SgpC87cypXPh8+ciedNZTlwToV9qK+zr7bVc6zWS8Y+jzKqqjZ8RMTkaPyZBcGrlAUUBEc0",
-	"jUeKgKSRcnWkWkOZSco9Ngwjsoo8gjylvkvXl0yklHmVyzhU/NSwfGagXKGFGClJsf1D42RqP0W8O1ym",
-	"Xsqcs4sa67nGL3g4Mx2aN5pnfGbwf5+2IQ/+u7oGZBu0QXrWNmzSerCfatNimpNx64Xr167+1zRO26xl",
-	"Gev0QLkxkjvv4crYFGQ5xh8nKUJpbrV0RYi6OLVDcZmtPTSUSxvyZTCyQTzYnlVzLpw+pCp2IOP/044J",
-	"qFhquSbki/6LbniUeZZ/cRYXt/RHZ34blDd+3lnKxig7T/+VSkovGbbMnqc3Gr8HAAD//82HIsO8IQAA",
+	"H4sIAAAAAAAC/+x97XLbRpboq6Bw54d9BxIpW56JVXV/JLYz43udRGXZTiqRrw2TsIQJCXAAULHsUZU+",
+	"4jhZeaxZT3YntTWxk8xW7f6kKTGmJZF+hcYrzJNsndPdQDfQIEF9UHTMH3FEEug+3X36fH880EtuteY6",
+	"lhP4+swDfdEyy5aHf15wncBygou2X3N9O7BdB74tW37Js2v0o06+I/vhlhZ+SRrkFdkjDY00w02yHa6G",
+	"G+Ql2SVt3dD90qJVNeHlYLlm6TO6H3i2s6CvrBj6pWvmgmLYp6QVroZrpA2Dr5JWuBZu4BeNSY08hx9J",
+	"i+yQBmmF6+FauKWRpnb57sQHZlBa1MjrcJW0NdImL8k+aZEO/teGr56Tp+Rv8MseabPnSIvs9wV0xdBr",
+	"pmdWrYBtzuW7OFcadFhQAmQ+X7hGXpN2uEa6ZFejzz1iS3mpkZekQV6HW+F6uBE+MbSPC1q4Aa/skQZ5",
+	"EW6GD+krsBot3AgfkXa4Hm6SZrwJkxr513isbdIle6RLmmRXAqELP3dII3zEtqVF9rX/rZGmsOU4CW5P",
+	"l32/xSfRDd2GdVJE0Q3dMauwVXz3pW207pnVWgV+ntfPzuu6odpYz/JrruNbuK/vu94du1y2ENdKFAPh",
+	"T7NWq9glEza5UPPcOxWr+us/+BQl4/l+5Vl39Rn9fxVipC7QX/3CJc9zPTpjAtl+IF2GD/BH+Ji8xGU3",
+	"wnXSInuwHV3yGg+qEX4V7w98Ji/xqL/GrWzoK4Z+2QkszzErdLrhLeIp6YQb4TpiU4d0wi2EO/yatMkL",
+	"xIBwDZfQpAsBUGc9q+Q6Zbza75t2xSoPFd6sG852dw0P4TVp8HuuhWtpzO6S/ejiw5quO2Y9WHQ9+/5Q",
+	"V/MT3GnYedjzdVwL/LtOmuEGws5JTgfpVou8or+SNkUyeBXpIZsKIHnP9Epu2VKQmHc/nJg6Gw3ZlE+e",
+	"tMkrDXdmBzE6XAs3yR7ZpY+2YTe12RufFqfOnJ0+95vfvnNeB9oWANbqM/r/PzU/X34wdXblT7M3PsU/",
+	"iyunf5W+uIZ+wQ6WAbaa59YsL7Dp/TVLgb3EYL5r1iuBPhN4dSt9/HQrGmQ3XCdtWAMeJZwtUp0d0qJP",
+	"4H0Mtyg+vATow/XwMaPkMVx3XLdimQ4AVvIsM7DK7+Kh33W9qhnoM3rZDKyJwK5aOlAcs/yRU1nmsKXW",
+	"RmnaA71q3rtiOQvBoj4zVSwaetV2os+K1zxrgXFJ4cUzOV4EuO67juKsyX+RBrsKXfKKHuhWuKZdfvfD",
+	"dw3YoQbjYvtwn7RLdTiNwgeuX3K/0PvMixD/sW57cFU+o4uOFiEAdTN6073zB6sUAMQXTbuyfNWquV6Q",
+	"RoISQ43UOuEQUqeiQq6a55brpeCCW6d3lz1gO4G1YHn4xNL9y2VprHrdLuvKUylZuJ2ZoyU2ggFFZzDo",
+	"alLDJGBM7ZGh35tYcCfYl5zHTYr7JjwyYVejrTThtPQFO1is35ksudWCWbHuWcsT/qJV9mynYC7ZgTsR",
+	"WH4wEZj+5wWbcZxC1S1blUI5cAt8OipicU6UwKxnTBppUClA5BZAKkCog7u4TxmhdvX9C9pv3yn+VjuV",
+	"RUhP60YSEZT0i/ydNHCqDulSOQYIPNkPNwXKJQCjwPPoMG45bnDLrVmOBUcVyxtLZsUuI4i37lLWpsCM",
+	"shWYdmXgrdlBoQDlJ8qGWsBgpekBnSw/0GIwtH5gUPJZpuzYrMwKG6mkoN+iVAKcsMO5SPiYdMJNyjyb",
+	"jNF0qMDSJS/EVbR0xZ22AFF8xXZ8Txog0Idfx+OB2LlHuQnIREx64iLUFtnXKEikQXk2ypKwo7qh24FV",
+	"9fvx2vdtq1JmDDeC1fQ8cxk+244fmE5JhVzPkRk+TkxLcQixK1wHvKbIRsHdYLJwQ5KYpAMt1Jbuq87O",
+	"D8ygrtiz31+7NjuBPL6BEv0aCgYofa3LQ08Xi9G4An0L7KCiWt5PgG0wsJaaIgHye2ZZu0rxUAU5/SI5",
+	"/vWrlzXkyK9JI3kJmyBCkm2UIMw7bj2YuVMxnc+lSeXvezMc/JWvNNrK6FoalHyouI+AHCnmcxd+64UX",
+	"u/zYnyCVY/JXAl9E1QwR5hHZDTcimauNai9sEmoB+6gdraqQXaAJbkXN60CxVAEsD071sQbKcHscoSWi",
+	"SHaAJAAm4AnuIymQlT0Ami1hG0eD775KAGpVaxV32bI0YCieGbiekq3WK1ZfWgFSXUuGvKuFa+FDSu+V",
+	"lzRB6Cm+GJrl1KuGVjXv2VX4g4msfDkMl4RFOPVqXwykuMLWokK02RufKmTcctmzfF8p4ZheadFeorpH",
+	"hpgpiKr86cPIqlzYSp8DeUmaMQuTRe1t0hWFbTQQvEQLBTsqwHh4kxLCAQVhO59kVjEDO6iXEyKhW7+D",
+	"F4UdtT5znk5IP0ycj8mlU6/eodSy4joLeYaaekcaa+od1WBV895HNcv5wHbqgeUrZfKINKyhvAKcdxd2",
+	"dB+kGiA0VHl5ibu+Gm4ikd6SLU4NLf5dNOPAUTWB5jDRq42kaI3ZqCLgp1Rco2rem6ViqT9reVe5lKSW",
+	"wYCP4EVrUNGB3tVdag2hkyLL6iIFBBCpQQjIJmJNh+kkwqJaeUCcC1zPXLBuuJV61bpQPZsPPMqxu+RF",
+	"+C8wWQoo4N/hQ0bVIotfM1YVI7ywneA3030BBanSdhZ+79Y9P0sxCzepGegFqv2bCgI2D4S8M0Gehmta",
+	"8fxMsThxZmqmWFQZwwy9tqhWAv+DdEknXGd3mO4EZ1wgppOOQmC/NDn1m2nVLKDh+YGHYulFM1BN+BTx",
+	"e5/ZZsg2SMOUw3Fpr6ldv3ZBN9REq++UV9ySWTngvOEjQSXej1Ri0koQtNSxD0RWY8Gul5Q6e+PTOfog",
+	"voJofcWu2sEHTPPp9fJc8vkDWQIUi9ZOgXngdJ5lLlmeryYQooGODmuojXJqi7LCBo22fsU1zAAySz1H",
+	"hpdX355dui/+dJx6NuCCFQS2s+CnZYY0TxGJj1OvVExgVRk70JOwDzyQivz2pI39Rz086q+opa+5DP0K",
+	"DIiUzHapCoTMqEktqdyYyCRdkQvj9/SSSOZF1M7YQ+FjPjbytHBDN6g4OfMZt28aul/3a5ZTRo2+VHF9",
+	"q6zfVNyv2RufXq+V1UT2O+6fCrfCJ4z3I4iROPFa8HR1mG7PVexJDdkC3P0dtKWAFrgpvRONyhSVLnml",
+	"G9nCrCDknSsWFYsZUXktyaiTxtdsNivYvefnf/3Z1MT5m2jzNqamV36lHwVXUKI1vchpInEntvj3Gp47",
+	"BphB9Zpd7c3CZT+nKDc1BuXjfL7+/LvPpD2Y+OH4dtmuWg6wtP6nRI/hYvxCfs3F9v364dQ2PkK0kwcb",
+	"JjKE5jaGB3XPORzo8RiHBN5ftGtVywkuq+w1z8J1IY4AbAO7gvlO1Dx2EeleU9ovYJpupPbjqMQ+ijpq",
+	"0e/SvZJlla2y0njHQOOgA+lfZ+bacDV8qKFRdZ/axAVlJxbt4O7Acn/G51raF6bn6EYOWwOAeLhz5yMc",
+	"8tTVhseUwSK2QmZbJjTmg7nFTYnJub6w7IXF4HeeWU3JXH2kTDaieL9yy5wUqKHJnXS693g8SsIFQ72h",
+	"aifa3cjrn/7Ns/x6hQYFRQZ7eWiLG2DTxM0pW/eUJk3w4bZZFEWSJ0jGQMFDIQBVi1lnjvuZOlQKl8rQ",
+	"JzsYEq/xPYw2LN6dAXHiPRYiM0zEuChxxMSJ/BWNF3AEGE6U5tPoBKP2ZG7kDh+mpMhFvGcXqv0umaFX",
+	"UDLL8+QXdjnPg4mzisaPBzBi8AY8LmHrhntmmWrPvwM1ZPYtZgf6CmnhnnR0M5pZAsJllbV/fvVUo5Qb",
+	"/6Ryh/YnjXPxW4F7ywdNxpucd8j3aSNaS61TSJpKPDc36nNdCgLXBFmwS3bnHUmfomDqnL3oXDTSDT0N",
+	"on4zB4Nhe3iN8RklPezBBxX+2ZpnlTgVTRzIP5gHDranie6yV4x1JRRM8CenAszCx9ydTPVX3C7ZsKmM",
+	"ceEBKrkiOgT4B8T/a5QJDgXzJZtGQketB+4FqmOr/HpqkzqVoDJN6cxfjfZF0opMV+2UF407S5pUtaHP",
+	"qQOPwCNduWqZvutkARo+oro6NTjgaKi0JyJR014eHLvCETdb7eo5bD58F+bKoedJEx6jYkeNLP2WLx5/",
+	"O6WG5t8CNlmO9admPE7lNpe6L5iyFHtwjCp//4mPb2fq1LkwhyGtKs75LXq1ojgZajfkcSKxefBV5mWM",
+	"DU45jQTJIDaFUyeHoy3pXTtMKFys3XL+azu3ap67gCZAjva6QAKURs1hOykCNzArvbyFP4YbZB9p/Gp/",
+	"P6F0vEZCdHlBA+i3RbGYuxeTcfdcFFL5NNKnhIv4WNZH+y8D7AJrB1oCvkkahwc8p4soCdMouIoiehlH",
+	"c7IrkFcQiqWSIYlBc8wm1t8ihidLmoyQARF+QdGAqgYpQZLS5ygUh5HCJ+mgTYHRHpBbDpW7daVtOTx/",
+	"OxjwB+QJQ6Dm/Sh4n6Ud+ZWKMHxYNyowA4X1ylyywGQbXfCLfSWIH5kOzJJrdnIIFAr1VyVQMKzw31u+",
+	"aC73shtTB19Xo1CQ/ZwyVYYBr5SNhTkj9dWR8yV1SLwijjdeONfWBweV25NzBZvmB23gtIFUgkA/DEst",
+	"P4kIuW8UYvjQrlPa1R/l+uiexQBNyoXhOkVbjAnHe5KUa/YNelNaaAb5GsWUb0ADF5wiKpfIjEYnZawB",
+	"pYgOTVgUJjDmHXCVRI4XFlkGD2GgEYSl4t2hdk4Mg3ulqfw6kv0qcr/gslWk9pr7uaWwaQT86z5oi4+p",
+	"8PW6bymin60qy2iILi795uAcC8OWBR7Do4N1Q4/jg2/2owwcChwtL17jEoeC1sBNrVLds4PlOfAhMH+8",
+	"ZXqW9249WIw/vc/36/9+fE3vlQcIXqtCuV6tLl9xF+woULlQgU+TGnmqpaS2drgVPmQCPGZ3AF1njAUE",
+	"GykNlZqw4GMLZfs16v+kEYmoWRrzjpTvsI+2LeRSG+Q1s0LJma0z9MawJVBNgg8XO+JwNpRA4/wJdpWi",
+	"JAdtungWbwr6ZNBShvsXo9hiENTo1tvOXVfFb1nCajtc41ZSAJwJsHtxRDvP6paVkC61xAoS8Lwz7ySi",
+	"br6hBm7B8xRuaqdmP5q7Zmiz1+Gfd69d+L2hXbx05dK1S6cT5AMUKSqGA1nagmGkTJxXSd0Gzd+3L5et",
+	"as0NLKe0PPH/rOXbTJbg8bQ7pKudOXdOY+GvTf72pAbWZjywryM1N9pvEAG45T5O12ZhsZiQytI42vMO",
+	"nluTIoYIKNsCRppRN/uZxgTvhU/CR4xIUwIJujwn2hv86TYPS+WSiIQdIoiCByNexLyTleMb71kwcdWq",
+	"VcxlqzyjgcB6GysCRMvREFlQHdhG5iEClGIQ1JOxxrLO8KHpM2cMeYc00hQMwGjiCzej3Ks4rwLzGnhc",
+	"9DbpSntKGtpEcqbi+UlEyWdS4h36SJt4J78RY7iyM/LCNe4tx63Kys+7rZ3iqRekod3G/Jnbp2fYYiEH",
+	"5jbEFd3GcwJWHa5CmAGg+P6gGXu3WVLbbW2CWdRzpqrB3je02zQX7XZUyyEevJWRfCZRQsb+eaycRlOv",
+	"pcwUFkwn5tQgJklJN+GmiNPzTuJE5RIJYrhdVwv/zOyJ7MoCff2Shi1woOGiI+WIQsjZFZXzZUgz3o+0",
+	"WYXeGX5DUXgCrF+LKM6+mHBzKpVQYzvJhBotXJ8kO5OnwR/4o5TBxuSzHVoXQSi+gYfBrliEBTPadLEI",
+	"59/BDElgQvB2HMIu7qahTRen4OFe+fPzTq8EeoF3wWhn+dQ7PEaUJXJ1SDfaYnhwWpuI5cpdRtHhH6hp",
+	"ssOHO69NUFTvhF8i9u2yvUcAdsMNJqyGa2y6brjFTVyGNj11Bu7/RsJb2KC7J5jR4jomBpAibULaJQYa",
+	"KJI/0315QSNW550kUTK0c3T3m30qQ0zOO1EW3ox+xyx9bjllzbe8Jbtk6YL9T5+aLE4WecSlWbP1Gf0s",
+	"foUVBBZRZiqUbC6LLlgoqoF4avIQNf13VnCBPiGXVPnsAa0r8se65S3HZUVsp1Spl63LThx4G5VqiLSO",
+	"u2bFV8Q8rdxMFBc5Uyz2qAmRrgWRK1EV6yCkI0jSNSJ+FKq/CPZwcPGuGPp0T9iOul7F96RF0U5xEyk0",
+	"U1mDRztakGpt4Etn+78UF3hZMfRzxWL/N+SKKqKojlgjCulZ2glggl+vVk1vmZ1EMpZMcSI111fg76zr",
+	"xwjM0rzfc8vLAyFWf3yS9SeQc1ZSyDx1DHOmwoPYplAm/iKWvN9anJ0unh/iouMDCDdQFkfe+A1pidzx",
+	"eK5S9v35VkYFqkKJHr5TchoDi3DZj6TKRhTE2zyNYDCuUXgAZH+FEveKFVjp+3cRv6c38EMa36JiI2gf",
+	"iLhIVNpEvFC96n2lOce0MnhOOJwd0hBvxpBwcfpEcDElIA3/YjxN1Apqces7S1WLf+XiUof71wW8FCsQ",
+	"tZksj9bJYV+pf8T4k75O3KXL/OYDXK5JTUTSNT7EQXekVldxxHowlOt4kqy2OFRWKxUufIsZ7QgRt2HS",
+	"gu8SdSsPwVyFapltNmZXLAoRZarLNsPdiFAIE1NGHVu00QyfKSNfjJ87+OWVfRtH44lQeyCGTAWoU0iF",
+	"h4KFl1oHGpGhto32ZbTTjx5ROOgFidH+uVSLiaUAUTmXGrK2SVcw8XCEtO6BT6gQeV/9yZK/1Mv8cAlf",
+	"iLyy/gV/Kc21FFVtHkV1MhrU/d+gIcaQfdtAixw4bTq0xJXClOEHphdcpB7y+DTyBKmsGOqKEGjvPCg4",
+	"llM+KmAE2hlFHChmZIX0BpG6kxgeWPeCAjtfBdh3bMfEGRVlZhMw/yetWJyqVzypYZyVVFv4NXWiUVeE",
+	"oUVWNjBVbkjFj+GXHebNAON5FPpGzW+vsGDHqvbJBEXCiTle8ypdd3kiUXhZdbPYWwVFqeYVQ0/OosrH",
+	"UlZSlqFlOefUwSiUdRPqmU1qABQoZtqEuKM/R3HhGI/CAtW6aOWed2gylTaRPAOa+Ig4RS39UGjlMRqm",
+	"v+Sn1iEtfgxAjmJPoylyCA5VnLmlYA8rY+vbUK1vT5OHLYVHJcJCwBODIX0X5m6A01z2wXEsk7D3+rUL",
+	"Ws5o8wwGcq/i3xuEg3wCz49ZyJvBQsSLveSUJ8GXca9aoXD7E+7du3bJKrulOsQoTvo1CI/0Fy0rqFYm",
+	"8f/Hx3uOhQ+8teRt+syZYdqjklStw/gmupK/IQ05IgNjR6GKJ9AaKBsWbnCDzBqO0WZ1aHAf29wuQAt+",
+	"C1PRx0AietMI+idX5j45Jope6a+eHq1mOkDUXc30/S9cr8zSmnNX5+YDRu+PVdhjoksnAg1L0Wmx2IiW",
+	"JtZ8Ohr9+i+qU8hsekFvEotHxujLnj59IWF4MM/+xTg5efR8+8KqBnbxRxFZzWRC91jfOHFvf8/DyeYa",
+	"CSw/GuZxoGYTqkz/g3GEo4sikG6LskkKVEWQK4yMowpOIqpAcRIjHl4QVYOSgB48zkBiaEK4QY1XT0pc",
+	"evhavPUj6eOU6YlcriTFOBMZWz1qgwxZoDwI+QBEEQp/jn2lJ0U+Ttxp+lzI3GpkkItU+G1bqBLbYmVe",
+	"4OQUJWWBCB2Y3Pi9ldFZ/tRRkYADFBI9XNnM/EnLGdmaR1Cqj8IwIkJQZpc4Vv5xpOUeLGPfQjWxyUSB",
+	"qOZ88uYbvAxBPFT4Nbp52uFDmhpi8DIWUesRuejcL1bMeiqWmubZcdmlZowoP4+0yTbvIxHnvibLObDd",
+	"FDNi5dJt4ZYRPSV2AmygTe7POM9+6rhoyggVB2k2BryC461G0SMNRW2a41XxckqJcvXCKDUDmqomqnqr",
+	"yTmmb6yjFXKHKot0s/EgE1S9wOhs4QH7YyWHicRnVJf9L5cweSd6NluezEXoh2o2yWUyEUsbAFZuILK9",
+	"jIxfavw0EDPFonloaKbMHd46eYIqJuul19D45dtdQCLDROF0+SLSTe1IuJG6W7yeLZObUsRVqoNsBm7V",
+	"Lmmn6OAbKJXthY8Y4XpyWm6GC9lymHi9TbpJlSJVRIAxQfTGNIDSheuQGpcAoGZ6gW1WJNaeWdg/nQ3X",
+	"kqo4GZF0ydsbsIxJwPREhmjsKZGTuuKrZQgtdaOoDa7hsyToV1GWYLoX2qlkVxsjdXySFMD3VbgBpHEa",
+	"Mjtzba4md8ujqbJFZDj82PalvacZbNlyNa+6ezTCdTVV9ILinljVlH/BkEJZGELUDTKqngxdjj8u6VxV",
+	"wKJq3rtMlz1VZPZO/llRKCangpGYnL4m7PWIqAcUI1Vs5Hl0CxKZwY2xdXRkxXYuY4erpC0xgLiqbETd",
+	"aJggdYXv4ROJahFRqi8ECNAulPDyXi9VYA/pfjdLXofi0NC98mfSkjSBcKN/oMQx4nfMDGi9kaaKpSfL",
+	"G3ZIK7XF4eYxyzlp2YafJuuat9NbG2HFPHvrI6x2RJuXROQMGNjd4XWVB+yvy+WVAlbYzmeTmuVvXcZ3",
+	"8mgr0UQ99ZV+hPzm8Zua+xlpICBlhzTGduWh2ZWjrT/5BMMkME1N6rXcYkX1DXUXGrJL6VscF9XibEIc",
+	"JrKzSClfcgGbJC0YMql7Si8BqFIJTUko78OLiR4xnaINAAYkVFfpS28XpZJUQkQhdbHXMSEbE7K3lZAJ",
+	"d2RdYfRJXZYjJma4zQPSsjl8560iZVLPS2VX59aYio2pWIqK8U5CRuTKTzRb73DDptAe5o0kZELWHkpl",
+	"Yi9BpUlbdYcOQdyW7vd0cy3dTxOscS7UAMB8z0JZVnnCJDXmfBVuZsxdMxcyKqNNGX1Mtfm6kvwZhQG8",
+	"DXE7jEYCPNLKAK8C1ZMz4CsKzX7PFgeG9jkrXIl4u4odAVZZ1dB270wy3/UyYEr3x48N+4qfWEpaxfQD",
+	"sVmEVOj/Zr5jbySL22asLGNJrle2vIw1mb7kn8BPMH8+0J5FzVTitAT0RIl+mtaMxoiU9s/Vb3nOb1SP",
+	"pxnVqGwINXtZZRs5Ywjoq5T6RNuJaMweuo5NtHZ5KlHi3XbC7gaFv+1g8Vac30nBowCEa+IA0ZhtWuox",
+	"cREUzWSaGr70MxoAXxna3Xqlkmv8ZErUvJNxqku29UXGocJkwqlyFmHoiQXrBn0011k/Fc4JU9DA7voQ",
+	"6wGxzJRe14pldbzrlRbtpRPL6ZC9aH36MP2UZoOw5/8n3k5lk44cTcDVbQSOYHrhaPMvO7Obqn/4wA4B",
+	"pH5jxERS2dnpgJ2U+r62kqfpa77eiEv3L0PB8iH1WxisrijLghxnGA070iVRuUaqkh+xAiwAvRc+Zn10",
+	"oQx0i7VJ4yJnK9U1jzkPt2njSP5Sn0SlpfvMDnDUNdOQrA3ZZ37jUyXS8W1Fuw7100gJ9NhErE/GPD4z",
+	"YjnyQpysXJMMq8vEhdnRtcuCf97oS9Yrdy86W7xXByiBGCmtBccyvTvLfXTXD+lD/TTY/6byOOqIrKw4",
+	"byrL732WNmQG+Ux0Zbd+p2LpgoJ0XlSQJs4XI+bAO0kpxDkIk90j2wcD1XUOCurUOxKsU+/kAvYHLGvf",
+	"ptXmBfhQzpb7j6vg9cyyjdWUFDLnORpjxOE7V6SfB1M3/47WozXWO4i1/aINMtJaM1dxZCVpcB35jAj3",
+	"VF+oj0mILtt+YDolVXO+H7BAk1TynvY2yMA2xWmmMSndIy2nyJ1XjKMXnTLKURXkmGSA3j3cK56X1A6f",
+	"aOwLYd9HuKjCLz7GWSiby4wjTDxZDbd4TsVafCPQWMCitWT7jmBegcNXnPKTmKU9wADHRMFsxQnlb08U",
+	"tZ8AcEE6ilDxJfqW2zzkmEXgc3cNHjvuRVsLH6ERvR0+TJjRIc54UjeUJb1nl+7P8mhNmfWqDjV+pHD5",
+	"7gc02sxQO8bYoEfpFJtW1tJjF7bFOm1A+10W1hbbUMauq6EsOo7flNxWkcOH+XjEkyGt4Tu14kjTqHx5",
+	"h7RkJ1WytzLNHJk603//Zz1sNIl1wN6n5Q+HLL7/JdrcqNzMgWqYf8fo32qy1bTS4pvRCo1WX2JJJW8a",
+	"jaSFCEabRB6LseF6DZvKDrsWQU+Tg3xgvwyjw9vKClQ84M0grooy8ZJz7jCmEiZXFrCF+C3wbd6SDPwZ",
+	"OXmHJqO9My2Ok75SoymS1wuw6CtJf+6oy6RHR/ziZSvvkxxVI8kqY1L4S0psGjlamBkR9TcBCdtSvXIM",
+	"Y2rxJrM9SIsiWYd65qFf50OpvGgGgfQX7VqVnWIGfZT6+MtRtUIPU2wiC8FcySg3jcbDW+VbgXvLtxwa",
+	"7pGDkM1x0HJFko40cYqWokLwZ9KWpqjTmMqMBpVJYP5JE4t+8PUUpXJGTEYEg5rpKMVg8Rd9YsLZZaZW",
+	"MrjNPArjRC9zZnhuuhXeWMU5/hsnVeLhNy/J06Ie+kKvuXBrFClESg4ZIn1IteJLChPbpJtO+O0oI6/b",
+	"5KXYH7qVljtOXbn8/keGdhSkRQzn6uHhRmoilJwbBhExeoa9yQnezUQRIN7CXAj2zw4JDxL+3yjilWUH",
+	"wI+Y7qTfzE3qRqM4Eiucw/xJu6lAobHrb7iuv5+kaj0QmcXldiin0+K9C7jsICZp0DDathbbqON3FPfa",
+	"t4LAdhaoU74evJHm63pEeeb4at4iI3a05hExY39PQwlQLnhF1V/BZcIqaoxtOWOz9gmYtZO4iVWet6M0",
+	"Ix5j+yrlBjyQZ/EZ+gY3EuXQWjRVsEMa0XwY09thkV+UNiqgalHqLcfnZ+t2cQuxI6tOdqhCWSddHWsQ",
+	"y7MY9zu2PL+Z1OoNibgwNGkBcaRXb4I7RJ01FSfdYcJePzv3gW1aMYkrPIj+Ros4RIlWjtNJOEyfYEyh",
+	"r8aLvECXeMQCrLCLJyXGJvptWyZ7QmhNc27Q1jRslJPuLjGQUxPsUTFyjJnLiDMXRZ0HgcmQxvDZjAqk",
+	"VMUK2wEPwIJn+f4bJJ8/i+5GI807FQxnQIFcrgcuxvHxUsp9+I+q9o4cH62KPlbS+VQ5nlzW0qOi48ZQ",
+	"6/xMj506b0z1HXXvhdGncCfoxEm7ZfLQLszAsMvHICV7lluznF+0lHyVLnGUpeRRkTWFilTYlJKxxA7p",
+	"jkXPseh5eJAk84aR+Kyhc7sdfo0EjJX4aQLyGaJxI2k2kfA23BJqkL+gRZliM8QbncPyXL6QyYChrHDD",
+	"I5SKOSuB0lOW18+izJ4a5dbmhu65FUt0z6s9rgP3RGcDn7QZ+7pvZdxNZa/tx3INixGk3EOlX5l7BEJc",
+	"A24P2dfw6I+raW18+X/AcJ82L3SXq2e6Z0EKuV8om3alZ7WJq/TBi/hcv4IT37KChOEaSnwQAdKe1Mhf",
+	"xfKAQEc6AN8LOE3m1cIoBKzmtcakxX1KsLZQRI+LjAC3iGuZbUcVDDKCbcq0/F2e6hD0yROJtsHNpRud",
+	"s4M7390GK0OHjDx8PA6vGW54zb/BzWbstMmqcgq4TyXlVG29dGW9KJL/JVN0WlIsPw/a93t2GuPCD/Dn",
+	"n6n69CLcVMhBiTg+2tCjkYwobiiVqLkIlLEveOBAf+kUxhaqUQ1BORlfL0/3z9ZdkvdzuMaqZ0l9IpWA",
+	"QKlgl8khLfIiUSrkqLy6ETksPOB/MvN5lhATUa256PlcVnJffPzkzDjKkplHUhBTTAfLSedkMuwLSVuZ",
+	"7esyihvxQT+2g0Uh1HsIdY76E+sxdR7KolMsUm2cOiHxLgVdW9l+hJcc3tWYXUVMFehFsnJ23ldQr4Ey",
+	"I46Sih2JxBd5BweX+qJXTzpUI2+jkHzdavSRrO0Z4zHzRvH+EpLm/dbQpvSmJKlVuDl8AbJ3OrGRgjcK",
+	"oG9zy4tcbmoAjD3pRvpiZgpmvMVNrIX++qIgTTtaSks5TI/9PmR9sOiOHmR+sNiOI6L3oxTawc9QxMlu",
+	"uJ44yrc4v2zkxLY0uWTP0YoRagY4OnRz9CJB+hYAODgVC8ze+bhz+MC4X9JhgPlrXCa9l8OCNeqJp+s/",
+	"8ndM9oDqrF/iCTO/bO+ZeCriiFRMQRRTOjtosBb1b9Hljd0cw9WDFUeQ37ORbJOE5pf/GQC2BuAy0v8A",
+	"AA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
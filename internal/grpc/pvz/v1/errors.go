@@ -0,0 +1,35 @@
+package pvzv1
+
+import (
+	"log"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var grpcCodes = map[apperror.Kind]codes.Code{
+	apperror.KindValidation:         codes.InvalidArgument,
+	apperror.KindUnauthorized:       codes.Unauthenticated,
+	apperror.KindForbidden:          codes.PermissionDenied,
+	apperror.KindNotFound:           codes.NotFound,
+	apperror.KindConflict:           codes.FailedPrecondition,
+	apperror.KindPreconditionFailed: codes.FailedPrecondition,
+	apperror.KindUnprocessable:      codes.InvalidArgument,
+	apperror.KindInternal:           codes.Internal,
+}
+
+// toStatus переводит ошибку сервиса в gRPC-статус по той же классификации, что и HTTP.
+func toStatus(err error) error {
+	appErr := apperror.From(err)
+	if appErr.Kind == apperror.KindInternal {
+		log.Printf("error: %v", err)
+	}
+
+	code, ok := grpcCodes[appErr.Kind]
+	if !ok {
+		code = codes.Internal
+	}
+
+	return status.Error(code, appErr.Message)
+}
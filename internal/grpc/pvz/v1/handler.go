@@ -2,13 +2,20 @@ package pvzv1
 
 import (
 	"context"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *PVZServer) GetPVZList(ctx context.Context, req *GetPVZListRequest) (*GetPVZListResponse, error) {
-	PVZsInfo, err := s.pvzService.GetPvz(nil, nil, nil, nil)
+	PVZsInfo, err := s.pvzService.GetPvz(&request.GetPvz{
+		Sort:  req.GetSort(),
+		Order: req.GetOrder(),
+		View:  entity.PvzViewSummary,
+	})
 	if err != nil {
-		return nil, err
+		return nil, toStatus(err)
 	}
 
 	var res []*PVZ
@@ -129,7 +129,11 @@ func (x *PVZ) GetCity() string {
 }
 
 type GetPVZListRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// registrationDate, city, lastReception or productCount
+	Sort string `protobuf:"bytes,1,opt,name=sort,proto3" json:"sort,omitempty"`
+	// asc or desc
+	Order         string `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -164,6 +168,20 @@ func (*GetPVZListRequest) Descriptor() ([]byte, []int) {
 	return file_pvz_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *GetPVZListRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *GetPVZListRequest) GetOrder() string {
+	if x != nil {
+		return x.Order
+	}
+	return ""
+}
+
 type GetPVZListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pvzs          []*PVZ                 `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
@@ -216,8 +234,10 @@ const file_pvz_proto_rawDesc = "" +
 	"\x03PVZ\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12G\n" +
 	"\x11registration_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x10registrationDate\x12\x12\n" +
-	"\x04city\x18\x03 \x01(\tR\x04city\"\x13\n" +
-	"\x11GetPVZListRequest\"5\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\"=\n" +
+	"\x11GetPVZListRequest\x12\x12\n" +
+	"\x04sort\x18\x01 \x01(\tR\x04sort\x12\x14\n" +
+	"\x05order\x18\x02 \x01(\tR\x05order\"5\n" +
 	"\x12GetPVZListResponse\x12\x1f\n" +
 	"\x04pvzs\x18\x01 \x03(\v2\v.pvz.v1.PVZR\x04pvzs*P\n" +
 	"\x0fReceptionStatus\x12 \n" +
@@ -226,7 +246,7 @@ const file_pvz_proto_rawDesc = "" +
 	"\n" +
 	"PVZService\x12C\n" +
 	"\n" +
-	"GetPVZList\x12\x19.pvz.v1.GetPVZListRequest\x1a\x1a.pvz.v1.GetPVZListResponseB:Z8github.com/EviL345/avito_test/internal/grpc/pvz/v1;pvzv1b\x06proto3"
+	"GetPVZList\x12\x19.pvz.v1.GetPVZListRequest\x1a\x1a.pvz.v1.GetPVZListResponseBFZDgithub.com/alexey-shedrin/avito-test-task/internal/grpc/pvz/v1;pvzv1b\x06proto3"
 
 var (
 	file_pvz_proto_rawDescOnce sync.Once
@@ -14,7 +14,7 @@ type PVZServer struct {
 	pvzService handler.PvzService
 }
 
-func Start(grpcServerPort string) {
+func Start(grpcServerPort string, pvzService handler.PvzService) {
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%s", grpcServerPort))
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
@@ -22,7 +22,7 @@ func Start(grpcServerPort string) {
 
 	server := grpc.NewServer()
 
-	RegisterPVZServiceServer(server, &PVZServer{})
+	RegisterPVZServiceServer(server, &PVZServer{pvzService: pvzService})
 
 	if err := server.Serve(listener); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"context"
+	"log"
+
+	openapi "github.com/alexey-shedrin/avito-test-task/internal/gen"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+)
+
+type CityService interface {
+	CreateCity(city *entity.City) (*entity.City, error)
+	GetCities(includeInactive bool) ([]*entity.City, error)
+	UpdateCity(name string, city *entity.City) (*entity.City, error)
+	DeleteCity(name string) error
+}
+
+func (h *Handler) GetCities(_ context.Context, req openapi.GetCitiesRequestObject) (openapi.GetCitiesResponseObject, error) {
+	log.SetPrefix("handler.GetCities")
+
+	includeInactive := req.Params.IncludeInactive != nil && *req.Params.IncludeInactive
+
+	cities, err := h.cityService.GetCities(includeInactive)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]openapi.City, len(cities))
+	for i, city := range cities {
+		resp[i] = cityResponse(city)
+	}
+
+	return openapi.GetCities200JSONResponse(resp), nil
+}
+
+func (h *Handler) PostCities(_ context.Context, req openapi.PostCitiesRequestObject) (openapi.PostCitiesResponseObject, error) {
+	log.SetPrefix("handler.PostCities")
+
+	city, err := h.cityService.CreateCity(cityEntity(req.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.PostCities201JSONResponse(cityResponse(city)), nil
+}
+
+func (h *Handler) PutCitiesName(_ context.Context, req openapi.PutCitiesNameRequestObject) (openapi.PutCitiesNameResponseObject, error) {
+	log.SetPrefix("handler.PutCitiesName")
+
+	city, err := h.cityService.UpdateCity(req.Name, cityEntity(req.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.PutCitiesName200JSONResponse(cityResponse(city)), nil
+}
+
+func (h *Handler) DeleteCitiesName(_ context.Context, req openapi.DeleteCitiesNameRequestObject) (openapi.DeleteCitiesNameResponseObject, error) {
+	log.SetPrefix("handler.DeleteCitiesName")
+
+	if err := h.cityService.DeleteCity(req.Name); err != nil {
+		return nil, err
+	}
+
+	return openapi.DeleteCitiesName204Response{}, nil
+}
+
+// cityEntity город из тела запроса. Без поля active город создается активным.
+func cityEntity(body *openapi.City) *entity.City {
+	city := &entity.City{
+		Name:     body.Name,
+		Region:   body.Region,
+		Timezone: body.Timezone,
+		Active:   true,
+	}
+
+	if body.Active != nil {
+		city.Active = *body.Active
+	}
+
+	return city
+}
+
+func cityResponse(city *entity.City) openapi.City {
+	resp := city.ToResponse()
+
+	return openapi.City{
+		Name:      resp.Name,
+		Region:    resp.Region,
+		Timezone:  resp.Timezone,
+		Active:    &resp.Active,
+		CreatedAt: &resp.CreatedAt,
+	}
+}
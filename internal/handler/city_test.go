@@ -0,0 +1,70 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	openapi "github.com/alexey-shedrin/avito-test-task/internal/gen"
+	"github.com/alexey-shedrin/avito-test-task/internal/handler"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/alexey-shedrin/avito-test-task/internal/service/mocks"
+	"github.com/alexey-shedrin/avito-test-task/internal/utils/httperror"
+	"github.com/alexey-shedrin/avito-test-task/internal/utils/token"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostCities_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockCityService(ctrl)
+	h := handler.New(handler.Services{City: mockService})
+
+	city := &entity.City{Name: "Екатеринбург", Region: "Свердловская область", Timezone: "Asia/Yekaterinburg", Active: true}
+	mockService.EXPECT().CreateCity(city).Return(city, nil)
+
+	r := setupRouter(t, h)
+
+	body, _ := json.Marshal(openapi.City{Name: city.Name, Region: city.Region, Timezone: city.Timezone})
+	req := httptest.NewRequest(http.MethodPost, "/cities", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestPostCities_MissingTimezone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := handler.New(handler.Services{City: mocks.NewMockCityService(ctrl)})
+
+	r := setupRouter(t, h)
+
+	body, _ := json.Marshal(gin.H{"name": "Екатеринбург", "region": "Свердловская область"})
+	req := httptest.NewRequest(http.MethodPost, "/cities", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Equal(t, httperror.ContentType, w.Header().Get("Content-Type"))
+
+	var resp httperror.Problem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, apperror.CodeValidationFailed, resp.Code)
+	require.Equal(t, []apperror.FieldError{{Field: "timezone", Rule: "required"}}, resp.Errors)
+}
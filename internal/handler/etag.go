@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
+)
+
+const (
+	ETagHeader    = "ETag"
+	IfMatchHeader = "If-Match"
+)
+
+var InvalidIfMatch = apperror.Validation("invalid_if_match", "invalid If-Match header")
+
+// etag версия ПВЗ или приемки в формате заголовка ETag.
+func etag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// ifMatch читает допустимые версии из заголовка If-Match: список ETag через запятую (RFC 9110).
+// Слабые ETag сравниваются по значению: прокси могут ослабить ETag ответа. Без заголовка
+// и для "*" возвращает nil: версия не проверяется.
+func ifMatch(header *string) ([]int64, error) {
+	if header == nil {
+		return nil, nil
+	}
+
+	value := strings.TrimSpace(*header)
+	if value == "" || value == "*" {
+		return nil, nil
+	}
+
+	tags := strings.Split(value, ",")
+	versions := make([]int64, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+			return nil, InvalidIfMatch
+		}
+
+		v, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 64)
+		if err != nil {
+			return nil, InvalidIfMatch
+		}
+
+		versions = append(versions, v)
+	}
+
+	return versions, nil
+}
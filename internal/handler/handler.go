@@ -1,15 +1,68 @@
 package handler
 
+import (
+	openapi "github.com/alexey-shedrin/avito-test-task/internal/gen"
+	"github.com/alexey-shedrin/avito-test-task/internal/middleware"
+	"github.com/alexey-shedrin/avito-test-task/internal/utils/httperror"
+	"github.com/gin-gonic/gin"
+)
+
+var _ openapi.StrictServerInterface = (*Handler)(nil)
+
 type Handler struct {
-	userService      UserService
-	pvzService       PvzService
-	receptionService ReceptionService
+	userService        UserService
+	pvzService         PvzService
+	receptionService   ReceptionService
+	reportService      ReportService
+	productTypeService ProductTypeService
+	cityService        CityService
+	shipmentService    ShipmentService
+}
+
+// Services перечисляет сервисы, которые использует Handler. Незаданные сервисы остаются nil,
+// обработчики соответствующих операций в этом случае вызывать нельзя.
+type Services struct {
+	User        UserService
+	Pvz         PvzService
+	Reception   ReceptionService
+	Report      ReportService
+	ProductType ProductTypeService
+	City        CityService
+	Shipment    ShipmentService
 }
 
-func New(userService UserService, pvzService PvzService, receptionService ReceptionService) *Handler {
+func New(services Services) *Handler {
 	return &Handler{
-		userService:      userService,
-		pvzService:       pvzService,
-		receptionService: receptionService,
+		userService:        services.User,
+		pvzService:         services.Pvz,
+		receptionService:   services.Reception,
+		reportService:      services.Report,
+		productTypeService: services.ProductType,
+		cityService:        services.City,
+		shipmentService:    services.Shipment,
 	}
 }
+
+// Register подключает обработчики строгого сервера к r. Запросы проверяются по встроенной
+// спецификации, ошибки обработчиков отдаются в формате problem+json. Middleware из
+// middlewares выполняются только для запросов, прошедших проверку и авторизацию.
+func Register(r gin.IRouter, h *Handler, middlewares ...gin.HandlerFunc) error {
+	swagger, err := openapi.GetSwagger()
+	if err != nil {
+		return err
+	}
+	swagger.Servers = nil
+
+	validator, err := middleware.OpenAPI(swagger)
+	if err != nil {
+		return err
+	}
+
+	r.Use(middleware.Errors(), validator)
+	r.Use(middlewares...)
+	openapi.RegisterHandlersWithOptions(r, openapi.NewStrictHandler(h, nil), openapi.GinServerOptions{
+		ErrorHandler: httperror.ErrorHandler,
+	})
+
+	return nil
+}
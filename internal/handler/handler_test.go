@@ -0,0 +1,40 @@
+package handler_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/handler"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func setupRouter(t *testing.T, h *handler.Handler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	require.NoError(t, handler.Register(r, h))
+
+	return r
+}
+
+func TestRegister_MiddlewaresRunAfterAuth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	calls := 0
+	require.NoError(t, handler.Register(r, handler.New(handler.Services{}), func(c *gin.Context) {
+		calls++
+		c.Next()
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/pvz", bytes.NewBufferString(`{"city":"Москва"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	require.Zero(t, calls)
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"context"
+	"log"
+
+	openapi "github.com/alexey-shedrin/avito-test-task/internal/gen"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+)
+
+type ProductTypeService interface {
+	CreateProductType(productType *entity.ProductType) (*entity.ProductType, error)
+	GetProductTypes(includeDeprecated bool) ([]*entity.ProductType, error)
+	SetProductTypeDeprecated(name string, deprecated bool) (*entity.ProductType, error)
+}
+
+func (h *Handler) GetProductTypes(_ context.Context, req openapi.GetProductTypesRequestObject) (openapi.GetProductTypesResponseObject, error) {
+	log.SetPrefix("handler.GetProductTypes")
+
+	includeDeprecated := req.Params.IncludeDeprecated != nil && *req.Params.IncludeDeprecated
+
+	productTypes, err := h.productTypeService.GetProductTypes(includeDeprecated)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]response.ProductType, len(productTypes))
+	for i, productType := range productTypes {
+		resp[i] = productType.ToResponse()
+	}
+
+	return openapi.GetProductTypes200JSONResponse(resp), nil
+}
+
+func (h *Handler) PostProductTypes(_ context.Context, req openapi.PostProductTypesRequestObject) (openapi.PostProductTypesResponseObject, error) {
+	log.SetPrefix("handler.PostProductTypes")
+
+	productType, err := h.productTypeService.CreateProductType(&entity.ProductType{Name: req.Body.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.PostProductTypes201JSONResponse(productType.ToResponse()), nil
+}
+
+func (h *Handler) PatchProductTypesName(_ context.Context, req openapi.PatchProductTypesNameRequestObject) (openapi.PatchProductTypesNameResponseObject, error) {
+	log.SetPrefix("handler.PatchProductTypesName")
+
+	productType, err := h.productTypeService.SetProductTypeDeprecated(req.Name, req.Body.Deprecated)
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.PatchProductTypesName200JSONResponse(productType.ToResponse()), nil
+}
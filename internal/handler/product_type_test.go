@@ -0,0 +1,89 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/handler"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/alexey-shedrin/avito-test-task/internal/service/mocks"
+	"github.com/alexey-shedrin/avito-test-task/internal/utils/token"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetProductTypes_IncludeDeprecated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockProductTypeService(ctrl)
+	h := handler.New(handler.Services{ProductType: mockService})
+
+	mockService.EXPECT().GetProductTypes(true).Return([]*entity.ProductType{
+		{Name: entity.ProductTypeShoes},
+		{Name: "хозтовары", Deprecated: true},
+	}, nil)
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodGet, "/product-types?includeDeprecated=true", nil)
+	jwt, _ := token.GenerateJWT(entity.EmployeeRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp []response.ProductType
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp, 2)
+	require.True(t, resp[1].Deprecated)
+}
+
+func TestPostProductTypes_EmployeeForbidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := handler.New(handler.Services{ProductType: mocks.NewMockProductTypeService(ctrl)})
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodPost, "/product-types", bytes.NewReader([]byte(`{"name":"косметика"}`)))
+	req.Header.Set("Content-Type", "application/json")
+	jwt, _ := token.GenerateJWT(entity.EmployeeRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestPatchProductTypesName_Deprecate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockProductTypeService(ctrl)
+	h := handler.New(handler.Services{ProductType: mockService})
+
+	mockService.EXPECT().SetProductTypeDeprecated(entity.ProductTypeShoes, true).
+		Return(&entity.ProductType{Name: entity.ProductTypeShoes, Deprecated: true}, nil)
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodPatch, "/product-types/"+url.PathEscape(entity.ProductTypeShoes), bytes.NewReader([]byte(`{"deprecated":true}`)))
+	req.Header.Set("Content-Type", "application/json")
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+}
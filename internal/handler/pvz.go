@@ -1,85 +1,197 @@
 package handler
 
 import (
+	"context"
 	"log"
-	"strings"
-	"time"
 
 	openapi "github.com/alexey-shedrin/avito-test-task/internal/gen"
-	"github.com/alexey-shedrin/avito-test-task/internal/middleware"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
-	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
-const InvalidCity = "invalid city"
+const (
+	defaultPage  = 1
+	defaultLimit = 10
+)
 
 type PvzService interface {
 	CreatePvz(pvz *entity.Pvz) (*entity.Pvz, error)
-	GetPvz(startDate, endDate *time.Time, page, limit *int) ([]response.PvzInfo, error)
+	GetPvz(req *request.GetPvz) ([]response.PvzInfo, error)
+	GetNearbyPvz(req *request.GetNearbyPvz) ([]response.NearbyPvz, error)
+	UpdatePvzSettings(pvzID uuid.UUID, settings entity.PvzSettings, versions []int64) (*entity.Pvz, error)
+	UpdatePvz(pvzID uuid.UUID, update entity.PvzUpdate, versions []int64) (*entity.Pvz, error)
+	ArchivePvz(pvzID uuid.UUID, versions []int64) error
 }
 
-func (h *Handler) PostPvz(c *gin.Context) {
+func (h *Handler) PostPvz(_ context.Context, req openapi.PostPvzRequestObject) (openapi.PostPvzResponseObject, error) {
 	log.SetPrefix("handler.PostPvz")
 
-	middleware.Auth(entity.ModeratorRole)(c)
-	if c.IsAborted() {
-		return
+	pvz, err := h.pvzService.CreatePvz(&entity.Pvz{City: req.Body.City})
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.PostPvz201JSONResponse{
+		Body:    pvz.ToResponse(),
+		Headers: openapi.PostPvz201ResponseHeaders{ETag: etag(pvz.Version)},
+	}, nil
+}
+
+func (h *Handler) GetPvz(_ context.Context, req openapi.GetPvzRequestObject) (openapi.GetPvzResponseObject, error) {
+	log.SetPrefix("handler.GetPvz")
+
+	params := req.Params
+
+	page, limit := defaultPage, defaultLimit
+	if params.Page != nil {
+		page = *params.Page
 	}
 
-	var req request.Pvz
-	if err := c.ShouldBindJSON(&req); err != nil {
-		if strings.Contains(err.Error(), "Field validation") {
-			c.JSON(400, gin.H{"error": InvalidCity})
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
 
-			return
-		}
+	getPvz := &request.GetPvz{
+		StartDate: params.StartDate,
+		EndDate:   params.EndDate,
+		Page:      &page,
+		Limit:     &limit,
+	}
 
-		log.Printf("error: %v", err)
-		c.JSON(400, gin.H{"error": err.Error()})
+	if params.Sort != nil {
+		getPvz.Sort = string(*params.Sort)
+	}
+
+	if params.Order != nil {
+		getPvz.Order = string(*params.Order)
+	}
 
-		return
+	if params.View != nil {
+		getPvz.View = string(*params.View)
 	}
 
-	pvz := &entity.Pvz{
-		City: req.City,
+	if params.IncludeArchived != nil {
+		getPvz.IncludeArchived = *params.IncludeArchived
 	}
 
-	pvz, err := h.pvzService.CreatePvz(pvz)
+	pvzList, err := h.pvzService.GetPvz(getPvz)
 	if err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
-		return
+		return nil, err
 	}
 
-	c.JSON(201, pvz.ToResponse())
+	return openapi.GetPvz200JSONResponse(pvzList), nil
 }
 
-func (h *Handler) GetPvz(c *gin.Context, params openapi.GetPvzParams) {
-	log.SetPrefix("handler.GetPvz")
+func (h *Handler) GetPvzNearby(_ context.Context, req openapi.GetPvzNearbyRequestObject) (openapi.GetPvzNearbyResponseObject, error) {
+	log.SetPrefix("handler.GetPvzNearby")
+
+	getNearby := &request.GetNearbyPvz{
+		Latitude:  req.Params.Lat,
+		Longitude: req.Params.Lon,
+	}
 
-	middleware.Auth(entity.EmployeeRole, entity.ModeratorRole)(c)
-	if c.IsAborted() {
-		return
+	if req.Params.Radius != nil {
+		getNearby.Radius = *req.Params.Radius
 	}
 
-	if params.Page == nil {
-		params.Page = new(int)
-		*params.Page = 1
+	if req.Params.Limit != nil {
+		getNearby.Limit = *req.Params.Limit
 	}
 
-	if params.Limit == nil {
-		params.Limit = new(int)
-		*params.Limit = 10
-	} else if *params.Limit > 30 {
-		*params.Limit = 10
+	pvzList, err := h.pvzService.GetNearbyPvz(getNearby)
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.GetPvzNearby200JSONResponse(pvzList), nil
+}
+
+func (h *Handler) PutPvzPvzIdSettings(_ context.Context, req openapi.PutPvzPvzIdSettingsRequestObject) (openapi.PutPvzPvzIdSettingsResponseObject, error) {
+	log.SetPrefix("handler.PutPvzPvzIdSettings")
+
+	if req.PvzId == uuid.Nil {
+		return nil, InvalidPvzId
 	}
 
-	pvzList, err := h.pvzService.GetPvz(params.StartDate, params.EndDate, params.Page, params.Limit)
+	versions, err := ifMatch(req.Params.IfMatch)
 	if err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
-		return
+		return nil, err
+	}
+
+	settings := entity.PvzSettings{
+		MaxProductsPerReception: req.Body.MaxProductsPerReception,
+		MaxOpenMinutes:          req.Body.MaxOpenMinutes,
+		MaxStorageVolumeCm3:     req.Body.MaxStorageVolumeCm3,
+	}
+
+	if req.Body.StorageLimitMode != nil {
+		settings.StorageLimitMode = string(*req.Body.StorageLimitMode)
+	}
+
+	pvz, err := h.pvzService.UpdatePvzSettings(req.PvzId, settings, versions)
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.PutPvzPvzIdSettings200JSONResponse{
+		Body:    pvz.ToResponse(),
+		Headers: openapi.PutPvzPvzIdSettings200ResponseHeaders{ETag: etag(pvz.Version)},
+	}, nil
+}
+
+func (h *Handler) PatchPvzPvzId(_ context.Context, req openapi.PatchPvzPvzIdRequestObject) (openapi.PatchPvzPvzIdResponseObject, error) {
+	log.SetPrefix("handler.PatchPvzPvzId")
+
+	if req.PvzId == uuid.Nil {
+		return nil, InvalidPvzId
+	}
+
+	versions, err := ifMatch(req.Params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	update := entity.PvzUpdate{
+		Address:      req.Body.Address,
+		Latitude:     req.Body.Latitude,
+		Longitude:    req.Body.Longitude,
+		OpeningHours: req.Body.OpeningHours,
+		Phone:        req.Body.Phone,
+	}
+
+	if req.Body.Status != nil {
+		status := string(*req.Body.Status)
+		update.Status = &status
+	}
+
+	pvz, err := h.pvzService.UpdatePvz(req.PvzId, update, versions)
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.PatchPvzPvzId200JSONResponse{
+		Body:    pvz.ToResponse(),
+		Headers: openapi.PatchPvzPvzId200ResponseHeaders{ETag: etag(pvz.Version)},
+	}, nil
+}
+
+func (h *Handler) DeletePvzPvzId(_ context.Context, req openapi.DeletePvzPvzIdRequestObject) (openapi.DeletePvzPvzIdResponseObject, error) {
+	log.SetPrefix("handler.DeletePvzPvzId")
+
+	if req.PvzId == uuid.Nil {
+		return nil, InvalidPvzId
+	}
+
+	versions, err := ifMatch(req.Params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.pvzService.ArchivePvz(req.PvzId, versions); err != nil {
+		return nil, err
 	}
 
-	c.JSON(200, pvzList)
+	return openapi.DeletePvzPvzId204Response{}, nil
 }
@@ -7,44 +7,36 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	openapi "github.com/alexey-shedrin/avito-test-task/internal/gen"
 	"github.com/alexey-shedrin/avito-test-task/internal/handler"
-	"github.com/alexey-shedrin/avito-test-task/internal/middleware"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/alexey-shedrin/avito-test-task/internal/service"
 	"github.com/alexey-shedrin/avito-test-task/internal/service/mocks"
+	"github.com/alexey-shedrin/avito-test-task/internal/utils/httperror"
 	"github.com/alexey-shedrin/avito-test-task/internal/utils/token"
-	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
-func setupPvzRouter(h *handler.Handler, setup func(*gin.Engine)) *gin.Engine {
-	gin.SetMode(gin.TestMode)
-	r := gin.New()
-	setup(r)
-	return r
-}
-
 func TestPostPvz_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockService := mocks.NewMockPvzService(ctrl)
-	h := handler.New(nil, mockService, nil)
+	h := handler.New(handler.Services{Pvz: mockService})
 
-	input := request.Pvz{City: "Москва"}
+	input := openapi.PostPvzJSONRequestBody{City: "Москва"}
 	expected := &entity.Pvz{City: "Москва"}
 
 	mockService.EXPECT().CreatePvz(gomock.Any()).Return(expected, nil)
 
 	body, _ := json.Marshal(input)
 
-	r := setupPvzRouter(h, func(r *gin.Engine) {
-		r.POST("/pvz", func(c *gin.Context) {
-			middleware.Auth(entity.ModeratorRole)(c)
-			h.PostPvz(c)
-		})
-	})
+	r := setupRouter(t, h)
 
 	req := httptest.NewRequest(http.MethodPost, "/pvz", bytes.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
@@ -61,14 +53,9 @@ func TestPostPvz_InvalidJSON(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	h := handler.New(nil, mocks.NewMockPvzService(ctrl), nil)
+	h := handler.New(handler.Services{Pvz: mocks.NewMockPvzService(ctrl)})
 
-	r := setupPvzRouter(h, func(r *gin.Engine) {
-		r.POST("/pvz", func(c *gin.Context) {
-			middleware.Auth(entity.ModeratorRole)(c)
-			h.PostPvz(c)
-		})
-	})
+	r := setupRouter(t, h)
 
 	req := httptest.NewRequest(http.MethodPost, "/pvz", bytes.NewReader([]byte("{bad json")))
 	req.Header.Set("Content-Type", "application/json")
@@ -80,3 +67,328 @@ func TestPostPvz_InvalidJSON(t *testing.T) {
 
 	require.Equal(t, http.StatusBadRequest, w.Code)
 }
+
+func TestGetPvz_Sort(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockPvzService(ctrl)
+	h := handler.New(handler.Services{Pvz: mockService})
+
+	mockService.EXPECT().GetPvz(gomock.Any()).DoAndReturn(func(req *request.GetPvz) ([]response.PvzInfo, error) {
+		require.Equal(t, entity.PvzSortCity, req.Sort)
+		require.Equal(t, entity.SortOrderDesc, req.Order)
+		return []response.PvzInfo{}, nil
+	})
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodGet, "/pvz?sort=city&order=desc", nil)
+	jwt, _ := token.GenerateJWT(entity.EmployeeRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestGetPvz_InvalidSort(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockPvzService(ctrl)
+	h := handler.New(handler.Services{Pvz: mockService})
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodGet, "/pvz?sort=id", nil)
+	jwt, _ := token.GenerateJWT(entity.EmployeeRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	var resp httperror.Problem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, []apperror.FieldError{
+		{Field: "sort", Rule: "enum", Param: "registrationDate city lastReception productCount"},
+	}, resp.Errors)
+}
+
+func TestPutPvzPvzIdSettings_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockPvzService(ctrl)
+	h := handler.New(handler.Services{Pvz: mockService})
+
+	pvzID := uuid.New()
+	maxProducts := 50
+	settings := entity.PvzSettings{MaxProductsPerReception: &maxProducts}
+
+	mockService.EXPECT().UpdatePvzSettings(pvzID, settings, nil).
+		Return(&entity.Pvz{Id: pvzID, City: "Москва", Settings: settings}, nil)
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodPut, "/pvz/"+pvzID.String()+"/settings", bytes.NewReader([]byte(`{"maxProductsPerReception":50}`)))
+	req.Header.Set("Content-Type", "application/json")
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp response.Pvz
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, 50, *resp.MaxProductsPerReception)
+	require.Nil(t, resp.MaxOpenMinutes)
+}
+
+func TestPutPvzPvzIdSettings_InvalidValue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := handler.New(handler.Services{Pvz: mocks.NewMockPvzService(ctrl)})
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodPut, "/pvz/"+uuid.NewString()+"/settings", bytes.NewReader([]byte(`{"maxOpenMinutes":0}`)))
+	req.Header.Set("Content-Type", "application/json")
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Equal(t, httperror.ContentType, w.Header().Get("Content-Type"))
+
+	var resp httperror.Problem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, apperror.CodeValidationFailed, resp.Code)
+	require.Equal(t, []apperror.FieldError{{Field: "maxOpenMinutes", Rule: "minimum", Param: "1"}}, resp.Errors)
+}
+
+func TestPatchPvzPvzId_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockPvzService(ctrl)
+	h := handler.New(handler.Services{Pvz: mockService})
+
+	pvzID := uuid.New()
+	status := entity.PvzStatusSuspended
+	update := entity.PvzUpdate{Status: &status}
+
+	mockService.EXPECT().UpdatePvz(pvzID, update, nil).
+		Return(&entity.Pvz{Id: pvzID, City: "Москва", Status: status, Version: 2}, nil)
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodPatch, "/pvz/"+pvzID.String(), bytes.NewReader([]byte(`{"status":"suspended"}`)))
+	req.Header.Set("Content-Type", "application/json")
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `"2"`, w.Header().Get(handler.ETagHeader))
+
+	var resp response.Pvz
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, entity.PvzStatusSuspended, resp.Status)
+	require.Equal(t, int64(2), resp.Version)
+}
+
+func TestPatchPvzPvzId_StaleIfMatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockPvzService(ctrl)
+	h := handler.New(handler.Services{Pvz: mockService})
+
+	pvzID := uuid.New()
+	status := entity.PvzStatusSuspended
+	versions := []int64{1}
+
+	mockService.EXPECT().UpdatePvz(pvzID, entity.PvzUpdate{Status: &status}, versions).
+		Return(nil, service.VersionMismatch)
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodPatch, "/pvz/"+pvzID.String(), bytes.NewReader([]byte(`{"status":"suspended"}`)))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(handler.IfMatchHeader, `"1"`)
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusPreconditionFailed, w.Code)
+}
+
+func TestDeletePvzPvzId_InvalidIfMatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := handler.New(handler.Services{Pvz: mocks.NewMockPvzService(ctrl)})
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodDelete, "/pvz/"+uuid.New().String(), nil)
+	req.Header.Set(handler.IfMatchHeader, `"1", 2`)
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), handler.InvalidIfMatch.Code)
+}
+
+func TestDeletePvzPvzId_IfMatchList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockPvzService(ctrl)
+	h := handler.New(handler.Services{Pvz: mockService})
+
+	pvzID := uuid.New()
+	mockService.EXPECT().ArchivePvz(pvzID, []int64{1, 2}).Return(nil)
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodDelete, "/pvz/"+pvzID.String(), nil)
+	req.Header.Set(handler.IfMatchHeader, `W/"1", "2"`)
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestPatchPvzPvzId_InvalidPhone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := handler.New(handler.Services{Pvz: mocks.NewMockPvzService(ctrl)})
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodPatch, "/pvz/"+uuid.NewString(), bytes.NewReader([]byte(`{"phone":"8-800-555-35-35"}`)))
+	req.Header.Set("Content-Type", "application/json")
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Equal(t, httperror.ContentType, w.Header().Get("Content-Type"))
+
+	var resp httperror.Problem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, apperror.CodeValidationFailed, resp.Code)
+	require.Equal(t, []apperror.FieldError{{Field: "phone", Rule: "pattern", Param: `^\+[1-9]\d{1,14}$`}}, resp.Errors)
+}
+
+func TestGetPvzNearby_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockPvzService(ctrl)
+	h := handler.New(handler.Services{Pvz: mockService})
+
+	pvzID := uuid.New()
+
+	mockService.EXPECT().GetNearbyPvz(&request.GetNearbyPvz{Latitude: 55.75, Longitude: 37.62, Radius: 1000}).
+		Return([]response.NearbyPvz{{Pvz: response.Pvz{Id: pvzID, City: "Москва"}, Distance: 420.5}}, nil)
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodGet, "/pvz/nearby?lat=55.75&lon=37.62&radius=1000", nil)
+	jwt, _ := token.GenerateJWT(entity.EmployeeRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp []response.NearbyPvz
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp, 1)
+	require.Equal(t, pvzID, resp[0].Pvz.Id)
+	require.Equal(t, 420.5, resp[0].Distance)
+}
+
+func TestGetPvzNearby_MissingLatitude(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := handler.New(handler.Services{Pvz: mocks.NewMockPvzService(ctrl)})
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodGet, "/pvz/nearby?lon=37.62", nil)
+	jwt, _ := token.GenerateJWT(entity.EmployeeRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestDeletePvzPvzId_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockPvzService(ctrl)
+	h := handler.New(handler.Services{Pvz: mockService})
+
+	pvzID := uuid.New()
+
+	mockService.EXPECT().ArchivePvz(pvzID, nil).Return(nil)
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodDelete, "/pvz/"+pvzID.String(), nil)
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestDeletePvzPvzId_Forbidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := handler.New(handler.Services{Pvz: mocks.NewMockPvzService(ctrl)})
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodDelete, "/pvz/"+uuid.NewString(), nil)
+	jwt, _ := token.GenerateJWT(entity.EmployeeRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+}
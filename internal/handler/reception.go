@@ -1,139 +1,307 @@
 package handler
 
 import (
+	"context"
+	"errors"
 	"log"
-	"strings"
 
-	"github.com/alexey-shedrin/avito-test-task/internal/middleware"
-	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
+	openapi "github.com/alexey-shedrin/avito-test-task/internal/gen"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
-	"github.com/gin-gonic/gin"
+	"github.com/alexey-shedrin/avito-test-task/internal/service"
 	"github.com/google/uuid"
 )
 
-const (
-	InvalidPvzId       = "invalid pvz id"
-	InvalidPvzIdOrType = "invalid pvz id or type"
+var (
+	InvalidPvzId       = apperror.Validation("invalid_pvz_id", "invalid pvz id")
+	InvalidReceptionId = apperror.Validation("invalid_reception_id", "invalid reception id")
+	InvalidProductId   = apperror.Validation("invalid_product_id", "invalid product id")
 )
 
 type ReceptionService interface {
 	CreateReception(reception *entity.Reception) (*entity.Reception, error)
 	CreateProduct(product *entity.Product, pvzID uuid.UUID) (*entity.Product, error)
+	CreateProducts(products []*entity.Product, pvzID uuid.UUID, mode string) ([]entity.ProductBatchResult, error)
+	GetProductsByBarcode(barcode string) ([]*entity.Product, error)
+	ChangeProductStatus(productID uuid.UUID, status string) (*entity.Product, error)
+	GetProductsOnHand(pvzID uuid.UUID, status string) ([]*entity.Product, error)
 	DeleteLastProduct(pvzID uuid.UUID) error
-	CloseLastReception(pvzID uuid.UUID) (*entity.Reception, error)
+	DeleteProduct(receptionID, productID uuid.UUID) error
+	CloseLastReception(pvzID uuid.UUID, versions []int64) (*entity.Reception, error)
+	ReopenReception(receptionID uuid.UUID, versions []int64) (*entity.Reception, error)
+	CancelReception(receptionID uuid.UUID, reason string, versions []int64) (*entity.Reception, error)
 }
 
-func (h *Handler) PostReceptions(c *gin.Context) {
+func (h *Handler) PostReceptions(_ context.Context, req openapi.PostReceptionsRequestObject) (openapi.PostReceptionsResponseObject, error) {
 	log.SetPrefix("handler.PostReceptions")
 
-	middleware.Auth(entity.EmployeeRole)(c)
-	if c.IsAborted() {
-		return
+	reception, err := h.receptionService.CreateReception(&entity.Reception{PvzId: req.Body.PvzId})
+	if err != nil {
+		return nil, err
 	}
 
-	var req request.Reception
-	if err := c.ShouldBindJSON(&req); err != nil {
-		if strings.Contains(err.Error(), "Field validation") {
-			c.JSON(400, gin.H{"error": InvalidPvzId})
+	return openapi.PostReceptions201JSONResponse{
+		Body:    reception.ToResponse(),
+		Headers: openapi.PostReceptions201ResponseHeaders{ETag: etag(reception.Version)},
+	}, nil
+}
+
+func (h *Handler) PostProducts(_ context.Context, req openapi.PostProductsRequestObject) (openapi.PostProductsResponseObject, error) {
+	log.SetPrefix("handler.PostProducts")
 
-			return
+	product := &entity.Product{
+		Type:        req.Body.Type,
+		Barcode:     req.Body.Barcode,
+		WeightGrams: req.Body.WeightGrams,
+		Dimensions:  productDimensions(req.Body.Dimensions),
+	}
+
+	product, err := h.receptionService.CreateProduct(product, req.Body.PvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.PostProducts201JSONResponse(*product.ToResponse()), nil
+}
+
+func (h *Handler) PostProductsBatch(_ context.Context, req openapi.PostProductsBatchRequestObject) (openapi.PostProductsBatchResponseObject, error) {
+	log.SetPrefix("handler.PostProductsBatch")
+
+	products := make([]*entity.Product, len(req.Body.Products))
+	for i, item := range req.Body.Products {
+		products[i] = &entity.Product{
+			Type:        item.Type,
+			Barcode:     item.Barcode,
+			WeightGrams: item.WeightGrams,
+			Dimensions:  productDimensions(item.Dimensions),
 		}
+	}
 
-		c.JSON(400, gin.H{"error": err.Error()})
+	var mode string
+	if req.Body.Mode != nil {
+		mode = string(*req.Body.Mode)
+	}
 
-		return
+	results, err := h.receptionService.CreateProducts(products, req.Body.PvzId, mode)
+	if err != nil && !errors.Is(err, service.ProductBatchRejected) {
+		return nil, err
 	}
 
-	reception := &entity.Reception{
-		PvzId: req.PvzId,
+	resp := response.ProductBatch{
+		Results: make([]response.ProductBatchResult, len(results)),
+	}
+	for i, result := range results {
+		resp.Results[i] = result.ToResponse(i)
+		if result.Err != nil {
+			resp.Failed++
+		} else if result.Product != nil {
+			resp.Created++
+		}
 	}
 
-	reception, err := h.receptionService.CreateReception(reception)
 	if err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
+		return openapi.PostProductsBatch422JSONResponse(resp), nil
+	}
+
+	return openapi.PostProductsBatch201JSONResponse(resp), nil
+}
+
+func (h *Handler) GetProductsBarcodeBarcode(_ context.Context, req openapi.GetProductsBarcodeBarcodeRequestObject) (openapi.GetProductsBarcodeBarcodeResponseObject, error) {
+	log.SetPrefix("handler.GetProductsBarcodeBarcode")
 
-		return
+	products, err := h.receptionService.GetProductsByBarcode(req.Barcode)
+	if err != nil {
+		return nil, err
 	}
 
-	c.JSON(201, reception.ToResponse())
+	return openapi.GetProductsBarcodeBarcode200JSONResponse(productsResponse(products)), nil
 }
 
-func (h *Handler) PostProducts(c *gin.Context) {
-	log.SetPrefix("handler.PostProducts")
+func (h *Handler) PostProductsProductIdStore(_ context.Context, req openapi.PostProductsProductIdStoreRequestObject) (openapi.PostProductsProductIdStoreResponseObject, error) {
+	log.SetPrefix("handler.PostProductsProductIdStore")
 
-	middleware.Auth(entity.EmployeeRole)(c)
-	if c.IsAborted() {
-		return
+	product, err := h.changeProductStatus(req.ProductId, entity.ProductStatusStored)
+	if err != nil {
+		return nil, err
 	}
 
-	var req request.CreateProduct
-	if err := c.ShouldBindJSON(&req); err != nil {
-		if strings.Contains(err.Error(), "Field validation") {
-			c.JSON(400, gin.H{"error": InvalidPvzIdOrType})
+	return openapi.PostProductsProductIdStore200JSONResponse(*product), nil
+}
 
-			return
-		}
+func (h *Handler) PostProductsProductIdIssue(_ context.Context, req openapi.PostProductsProductIdIssueRequestObject) (openapi.PostProductsProductIdIssueResponseObject, error) {
+	log.SetPrefix("handler.PostProductsProductIdIssue")
 
-		c.JSON(400, gin.H{"error": err.Error()})
+	product, err := h.changeProductStatus(req.ProductId, entity.ProductStatusIssued)
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.PostProductsProductIdIssue200JSONResponse(*product), nil
+}
 
-		return
+func (h *Handler) PostProductsProductIdReturn(_ context.Context, req openapi.PostProductsProductIdReturnRequestObject) (openapi.PostProductsProductIdReturnResponseObject, error) {
+	log.SetPrefix("handler.PostProductsProductIdReturn")
+
+	product, err := h.changeProductStatus(req.ProductId, entity.ProductStatusReturnedToSender)
+	if err != nil {
+		return nil, err
 	}
 
-	product := &entity.Product{
-		Type: req.Type,
+	return openapi.PostProductsProductIdReturn200JSONResponse(*product), nil
+}
+
+func (h *Handler) changeProductStatus(productId uuid.UUID, status string) (*response.Product, error) {
+	if productId == uuid.Nil {
+		return nil, InvalidProductId
 	}
 
-	product, err := h.receptionService.CreateProduct(product, req.PvzId)
+	product, err := h.receptionService.ChangeProductStatus(productId, status)
 	if err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
+		return nil, err
+	}
+
+	return product.ToResponse(), nil
+}
+
+func (h *Handler) GetPvzPvzIdProducts(_ context.Context, req openapi.GetPvzPvzIdProductsRequestObject) (openapi.GetPvzPvzIdProductsResponseObject, error) {
+	log.SetPrefix("handler.GetPvzPvzIdProducts")
 
-		return
+	if req.PvzId == uuid.Nil {
+		return nil, InvalidPvzId
 	}
 
-	c.JSON(201, product.ToResponse())
+	var status string
+	if req.Params.Status != nil {
+		status = string(*req.Params.Status)
+	}
+
+	products, err := h.receptionService.GetProductsOnHand(req.PvzId, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.GetPvzPvzIdProducts200JSONResponse(productsResponse(products)), nil
 }
 
-func (h *Handler) PostPvzPvzIdDeleteLastProduct(c *gin.Context, pvzId uuid.UUID) {
+func (h *Handler) PostPvzPvzIdDeleteLastProduct(_ context.Context, req openapi.PostPvzPvzIdDeleteLastProductRequestObject) (openapi.PostPvzPvzIdDeleteLastProductResponseObject, error) {
 	log.SetPrefix("handler.PostPvzPvzIdDeleteLastProduct")
 
-	middleware.Auth(entity.EmployeeRole)(c)
-	if c.IsAborted() {
-		return
+	if req.PvzId == uuid.Nil {
+		return nil, InvalidPvzId
+	}
+
+	if err := h.receptionService.DeleteLastProduct(req.PvzId); err != nil {
+		return nil, err
 	}
 
-	if pvzId == uuid.Nil {
-		c.JSON(400, gin.H{"error": InvalidPvzId})
+	return openapi.PostPvzPvzIdDeleteLastProduct200Response{}, nil
+}
+
+func (h *Handler) DeleteReceptionsReceptionIdProductsProductId(_ context.Context, req openapi.DeleteReceptionsReceptionIdProductsProductIdRequestObject) (openapi.DeleteReceptionsReceptionIdProductsProductIdResponseObject, error) {
+	log.SetPrefix("handler.DeleteReceptionsReceptionIdProductsProductId")
 
-		return
+	if req.ReceptionId == uuid.Nil {
+		return nil, InvalidReceptionId
 	}
 
-	err := h.receptionService.DeleteLastProduct(pvzId)
-	if err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
-		return
+	if req.ProductId == uuid.Nil {
+		return nil, InvalidProductId
+	}
+
+	if err := h.receptionService.DeleteProduct(req.ReceptionId, req.ProductId); err != nil {
+		return nil, err
 	}
 
-	c.Status(200)
+	return openapi.DeleteReceptionsReceptionIdProductsProductId204Response{}, nil
 }
 
-func (h *Handler) PostPvzPvzIdCloseLastReception(c *gin.Context, pvzId uuid.UUID) {
+func (h *Handler) PostPvzPvzIdCloseLastReception(_ context.Context, req openapi.PostPvzPvzIdCloseLastReceptionRequestObject) (openapi.PostPvzPvzIdCloseLastReceptionResponseObject, error) {
 	log.SetPrefix("handler.PostPvzPvzIdCloseLastReception")
 
-	middleware.Auth(entity.EmployeeRole)(c)
-	if c.IsAborted() {
-		return
+	if req.PvzId == uuid.Nil {
+		return nil, InvalidPvzId
 	}
 
-	if pvzId == uuid.Nil {
-		c.JSON(400, gin.H{"error": InvalidPvzId})
-		return
+	versions, err := ifMatch(req.Params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	reception, err := h.receptionService.CloseLastReception(req.PvzId, versions)
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.PostPvzPvzIdCloseLastReception200JSONResponse{
+		Body:    reception.ToResponse(),
+		Headers: openapi.PostPvzPvzIdCloseLastReception200ResponseHeaders{ETag: etag(reception.Version)},
+	}, nil
+}
+
+func (h *Handler) PostReceptionsReceptionIdReopen(_ context.Context, req openapi.PostReceptionsReceptionIdReopenRequestObject) (openapi.PostReceptionsReceptionIdReopenResponseObject, error) {
+	log.SetPrefix("handler.PostReceptionsReceptionIdReopen")
+
+	if req.ReceptionId == uuid.Nil {
+		return nil, InvalidReceptionId
+	}
+
+	versions, err := ifMatch(req.Params.IfMatch)
+	if err != nil {
+		return nil, err
 	}
 
-	reception, err := h.receptionService.CloseLastReception(pvzId)
+	reception, err := h.receptionService.ReopenReception(req.ReceptionId, versions)
 	if err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
-		return
+		return nil, err
+	}
+
+	return openapi.PostReceptionsReceptionIdReopen200JSONResponse{
+		Body:    reception.ToResponse(),
+		Headers: openapi.PostReceptionsReceptionIdReopen200ResponseHeaders{ETag: etag(reception.Version)},
+	}, nil
+}
+
+func (h *Handler) PostReceptionsReceptionIdCancel(_ context.Context, req openapi.PostReceptionsReceptionIdCancelRequestObject) (openapi.PostReceptionsReceptionIdCancelResponseObject, error) {
+	log.SetPrefix("handler.PostReceptionsReceptionIdCancel")
+
+	if req.ReceptionId == uuid.Nil {
+		return nil, InvalidReceptionId
+	}
+
+	versions, err := ifMatch(req.Params.IfMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	reception, err := h.receptionService.CancelReception(req.ReceptionId, req.Body.Reason, versions)
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.PostReceptionsReceptionIdCancel200JSONResponse{
+		Body:    reception.ToResponse(),
+		Headers: openapi.PostReceptionsReceptionIdCancel200ResponseHeaders{ETag: etag(reception.Version)},
+	}, nil
+}
+
+func productDimensions(d *response.ProductDimensions) *entity.ProductDimensions {
+	if d == nil {
+		return nil
+	}
+
+	return &entity.ProductDimensions{
+		LengthCm: d.LengthCm,
+		WidthCm:  d.WidthCm,
+		HeightCm: d.HeightCm,
+	}
+}
+
+func productsResponse(products []*entity.Product) []response.Product {
+	resp := make([]response.Product, len(products))
+	for i, product := range products {
+		resp[i] = *product.ToResponse()
 	}
 
-	c.JSON(200, reception.ToResponse())
+	return resp
 }
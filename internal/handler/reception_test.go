@@ -3,17 +3,19 @@ package handler_test
 import (
 	"bytes"
 	"encoding/json"
-	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/alexey-shedrin/avito-test-task/internal/utils/httperror"
 	"github.com/alexey-shedrin/avito-test-task/internal/utils/token"
 
+	openapi "github.com/alexey-shedrin/avito-test-task/internal/gen"
 	"github.com/alexey-shedrin/avito-test-task/internal/handler"
-	"github.com/alexey-shedrin/avito-test-task/internal/middleware"
-	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/alexey-shedrin/avito-test-task/internal/service"
 	"github.com/alexey-shedrin/avito-test-task/internal/service/mocks"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -21,34 +23,22 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
-func setupRouter(h *handler.Handler, setup func(*gin.Engine)) *gin.Engine {
-	gin.SetMode(gin.TestMode)
-	router := gin.New()
-	setup(router)
-	return router
-}
-
 func TestPostReceptions_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockReceptionService := mocks.NewMockReceptionService(ctrl)
 
-	h := handler.New(nil, nil, mockReceptionService)
+	h := handler.New(handler.Services{Reception: mockReceptionService})
 
 	pvzID := uuid.New()
-	input := request.Reception{PvzId: pvzID}
+	input := openapi.PostReceptionsJSONBody{PvzId: pvzID}
 	expected := &entity.Reception{PvzId: pvzID}
 
 	mockReceptionService.EXPECT().CreateReception(gomock.Any()).Return(expected, nil)
 
 	body, _ := json.Marshal(input)
-	router := setupRouter(h, func(r *gin.Engine) {
-		r.POST("/receptions", func(c *gin.Context) {
-			middleware.Auth(entity.EmployeeRole)(c)
-			h.PostReceptions(c)
-		})
-	})
+	router := setupRouter(t, h)
 
 	req := httptest.NewRequest(http.MethodPost, "/receptions", bytes.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
@@ -65,14 +55,9 @@ func TestPostProducts_InvalidBody(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	h := handler.New(nil, nil, mocks.NewMockReceptionService(ctrl))
+	h := handler.New(handler.Services{Reception: mocks.NewMockReceptionService(ctrl)})
 
-	router := setupRouter(h, func(r *gin.Engine) {
-		r.POST("/products", func(c *gin.Context) {
-			middleware.Auth(entity.EmployeeRole)(c)
-			h.PostProducts(c)
-		})
-	})
+	router := setupRouter(t, h)
 
 	req := httptest.NewRequest(http.MethodPost, "/products", bytes.NewReader([]byte("invalid")))
 	req.Header.Set("Content-Type", "application/json")
@@ -90,20 +75,14 @@ func TestPostPvzPvzIdDeleteLastProduct_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockService := mocks.NewMockReceptionService(ctrl)
-	h := handler.New(nil, nil, mockService)
+	h := handler.New(handler.Services{Reception: mockService})
 
 	pvzID := uuid.New()
 	mockService.EXPECT().DeleteLastProduct(pvzID).Return(nil)
 
-	router := setupRouter(h, func(r *gin.Engine) {
-		r.POST("/pvz/:id/delete-last", func(c *gin.Context) {
-			middleware.Auth(entity.EmployeeRole)(c)
-			id, _ := uuid.Parse(c.Param("id"))
-			h.PostPvzPvzIdDeleteLastProduct(c, id)
-		})
-	})
+	router := setupRouter(t, h)
 
-	req := httptest.NewRequest(http.MethodPost, "/pvz/"+pvzID.String()+"/delete-last", nil)
+	req := httptest.NewRequest(http.MethodPost, "/pvz/"+pvzID.String()+"/delete_last_product", nil)
 	jwt, _ := token.GenerateJWT(entity.EmployeeRole)
 	req.Header.Set("Authorization", jwt)
 
@@ -118,25 +97,368 @@ func TestPostPvzPvzIdCloseLastReception_Error(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockService := mocks.NewMockReceptionService(ctrl)
-	h := handler.New(nil, nil, mockService)
+	h := handler.New(handler.Services{Reception: mockService})
 
 	pvzID := uuid.New()
-	mockService.EXPECT().CloseLastReception(pvzID).Return(nil, errors.New("some error"))
+	mockService.EXPECT().CloseLastReception(pvzID, nil).Return(nil, service.ReceptionNotOpened)
+
+	router := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodPost, "/pvz/"+pvzID.String()+"/close_last_reception", nil)
+	jwt, _ := token.GenerateJWT(entity.EmployeeRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusConflict, w.Code)
+
+	var resp httperror.Problem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, "reception_not_opened", resp.Code)
+}
+
+func TestPostReceptionsReceptionIdCancel_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReceptionService(ctrl)
+	h := handler.New(handler.Services{Reception: mockService})
+
+	receptionID := uuid.New()
+	reason := "opened by mistake"
+	mockService.EXPECT().CancelReception(receptionID, reason, nil).
+		Return(&entity.Reception{Id: receptionID, Status: entity.ReceptionStatusCancelled, CancelReason: &reason}, nil)
+
+	router := setupRouter(t, h)
+
+	body, _ := json.Marshal(openapi.PostReceptionsReceptionIdCancelJSONBody{Reason: reason})
+	req := httptest.NewRequest(http.MethodPost, "/receptions/"+receptionID.String()+"/cancel", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestPostReceptionsReceptionIdCancel_MissingReason(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := handler.New(handler.Services{Reception: mocks.NewMockReceptionService(ctrl)})
+
+	router := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodPost, "/receptions/"+uuid.NewString()+"/cancel", bytes.NewReader([]byte(`{}`)))
+	req.Header.Set("Content-Type", "application/json")
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestPostReceptionsReceptionIdReopen_EmployeeForbidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := handler.New(handler.Services{Reception: mocks.NewMockReceptionService(ctrl)})
+
+	router := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodPost, "/receptions/"+uuid.NewString()+"/reopen", nil)
+	jwt, _ := token.GenerateJWT(entity.EmployeeRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestDeleteReceptionsReceptionIdProductsProductId_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReceptionService(ctrl)
+	h := handler.New(handler.Services{Reception: mockService})
+
+	receptionID := uuid.New()
+	productID := uuid.New()
+	mockService.EXPECT().DeleteProduct(receptionID, productID).Return(nil)
+
+	router := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodDelete, "/receptions/"+receptionID.String()+"/products/"+productID.String(), nil)
+	jwt, _ := token.GenerateJWT(entity.EmployeeRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestDeleteReceptionsReceptionIdProductsProductId_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReceptionService(ctrl)
+	h := handler.New(handler.Services{Reception: mockService})
+
+	receptionID := uuid.New()
+	productID := uuid.New()
+	mockService.EXPECT().DeleteProduct(receptionID, productID).Return(service.ProductNotFound)
+
+	router := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodDelete, "/receptions/"+receptionID.String()+"/products/"+productID.String(), nil)
+	jwt, _ := token.GenerateJWT(entity.EmployeeRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestPostProductsBatch_Partial(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReceptionService(ctrl)
+	h := handler.New(handler.Services{Reception: mockService})
+
+	pvzID := uuid.New()
+	mockService.EXPECT().CreateProducts(gomock.Len(2), pvzID, entity.ProductBatchModePartial).
+		Return([]entity.ProductBatchResult{
+			{Product: &entity.Product{Id: uuid.New(), Type: entity.ProductTypeShoes}},
+			{Err: service.InvalidProductType},
+		}, nil)
+
+	router := setupRouter(t, h)
+
+	body, _ := json.Marshal(gin.H{
+		"pvzId":    pvzID,
+		"mode":     entity.ProductBatchModePartial,
+		"products": []gin.H{{"type": entity.ProductTypeShoes}, {"type": "мебель"}},
+	})
+	req := httptest.NewRequest(http.MethodPost, "/products/batch", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	jwt, _ := token.GenerateJWT(entity.EmployeeRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var resp response.ProductBatch
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, 1, resp.Created)
+	require.Equal(t, 1, resp.Failed)
+	require.Equal(t, service.InvalidProductType.Error(), resp.Results[1].Error)
+}
 
-	router := setupRouter(h, func(r *gin.Engine) {
-		r.POST("/pvz/:id/close", func(c *gin.Context) {
-			middleware.Auth(entity.EmployeeRole)(c)
-			id, _ := uuid.Parse(c.Param("id"))
-			h.PostPvzPvzIdCloseLastReception(c, id)
+func TestPostProductsBatch_AtomicRejected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReceptionService(ctrl)
+	h := handler.New(handler.Services{Reception: mockService})
+
+	pvzID := uuid.New()
+	mockService.EXPECT().CreateProducts(gomock.Len(1), pvzID, "").
+		Return([]entity.ProductBatchResult{{Err: service.ProductLimitReached}}, service.ProductBatchRejected)
+
+	router := setupRouter(t, h)
+
+	body, _ := json.Marshal(gin.H{
+		"pvzId":    pvzID,
+		"products": []gin.H{{"type": entity.ProductTypeShoes}},
+	})
+	req := httptest.NewRequest(http.MethodPost, "/products/batch", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	jwt, _ := token.GenerateJWT(entity.EmployeeRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
+
+func TestPostProducts_DuplicateScan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReceptionService(ctrl)
+	h := handler.New(handler.Services{Reception: mockService})
+
+	mockService.EXPECT().CreateProduct(gomock.Any(), gomock.Any()).Return(nil, service.DuplicateScan)
+
+	router := setupRouter(t, h)
+
+	barcode := "PVZ0123456789"
+	body, _ := json.Marshal(openapi.PostProductsJSONBody{PvzId: uuid.New(), Type: entity.ProductTypeShoes, Barcode: &barcode})
+	req := httptest.NewRequest(http.MethodPost, "/products", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	jwt, _ := token.GenerateJWT(entity.EmployeeRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestPostProducts_SizeAndStorageWarning(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReceptionService(ctrl)
+	h := handler.New(handler.Services{Reception: mockService})
+
+	weight := 750
+	mockService.EXPECT().CreateProduct(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(product *entity.Product, _ uuid.UUID) (*entity.Product, error) {
+			require.Equal(t, &weight, product.WeightGrams)
+			require.Equal(t, &entity.ProductDimensions{LengthCm: 40, WidthCm: 30, HeightCm: 15}, product.Dimensions)
+			product.StorageLimitExceeded = true
+			return product, nil
 		})
+
+	router := setupRouter(t, h)
+
+	body, _ := json.Marshal(openapi.PostProductsJSONBody{
+		PvzId:       uuid.New(),
+		Type:        entity.ProductTypeShoes,
+		WeightGrams: &weight,
+		Dimensions:  &response.ProductDimensions{LengthCm: 40, WidthCm: 30, HeightCm: 15},
 	})
+	req := httptest.NewRequest(http.MethodPost, "/products", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	jwt, _ := token.GenerateJWT(entity.EmployeeRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var resp response.Product
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.True(t, resp.StorageLimitExceeded)
+	require.Equal(t, 40, resp.Dimensions.LengthCm)
+}
+
+func TestGetProductsBarcodeBarcode_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReceptionService(ctrl)
+	h := handler.New(handler.Services{Reception: mockService})
+
+	barcode := "4006381333931"
+	mockService.EXPECT().GetProductsByBarcode(barcode).
+		Return([]*entity.Product{{Id: uuid.New(), Type: entity.ProductTypeShoes, Barcode: &barcode}}, nil)
+
+	router := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodGet, "/products/barcode/"+barcode, nil)
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
 
-	req := httptest.NewRequest(http.MethodPost, "/pvz/"+pvzID.String()+"/close", nil)
+	var resp []response.Product
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp, 1)
+	require.Equal(t, barcode, *resp[0].Barcode)
+}
+
+func TestPostProductsProductIdIssue_Conflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReceptionService(ctrl)
+	h := handler.New(handler.Services{Reception: mockService})
+
+	productID := uuid.New()
+	mockService.EXPECT().ChangeProductStatus(productID, entity.ProductStatusIssued).Return(nil, service.ProductStatusConflict)
+
+	router := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodPost, "/products/"+productID.String()+"/issue", nil)
 	jwt, _ := token.GenerateJWT(entity.EmployeeRole)
 	req.Header.Set("Authorization", jwt)
 
 	w := httptest.NewRecorder()
 	router.ServeHTTP(w, req)
 
-	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestPostProductsProductIdStore_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReceptionService(ctrl)
+	h := handler.New(handler.Services{Reception: mockService})
+
+	productID := uuid.New()
+	storedAt := time.Now().UTC()
+	mockService.EXPECT().ChangeProductStatus(productID, entity.ProductStatusStored).
+		Return(&entity.Product{Id: productID, Status: entity.ProductStatusStored, StoredAt: &storedAt}, nil)
+
+	router := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodPost, "/products/"+productID.String()+"/store", nil)
+	jwt, _ := token.GenerateJWT(entity.EmployeeRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp response.Product
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, entity.ProductStatusStored, resp.Status)
+	require.NotNil(t, resp.StoredAt)
+}
+
+func TestGetPvzPvzIdProducts_StatusFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReceptionService(ctrl)
+	h := handler.New(handler.Services{Reception: mockService})
+
+	pvzID := uuid.New()
+	mockService.EXPECT().GetProductsOnHand(pvzID, entity.ProductStatusStored).
+		Return([]*entity.Product{{Id: uuid.New(), Status: entity.ProductStatusStored}}, nil)
+
+	router := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodGet, "/pvz/"+pvzID.String()+"/products?status=stored", nil)
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp []response.Product
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp, 1)
 }
@@ -0,0 +1,261 @@
+package handler
+
+import (
+	"context"
+	"encoding/csv"
+	"log"
+	"net/http"
+	"time"
+
+	openapi "github.com/alexey-shedrin/avito-test-task/internal/gen"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/xuri/excelize/v2"
+)
+
+// ExportStatusTrailer трейлер потоковой выгрузки: complete, если выгружены все строки, и
+// failed, если выгрузка оборвалась после начала ответа со статусом 200.
+const (
+	ExportStatusTrailer  = "X-Export-Status"
+	ExportStatusComplete = "complete"
+	ExportStatusFailed   = "failed"
+)
+
+const (
+	csvDisposition  = `attachment; filename="receptions.csv"`
+	xlsxDisposition = `attachment; filename="receptions.xlsx"`
+	xlsxContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	csvFlushRows    = 1000
+)
+
+// ExportTooLarge выгрузка не помещается на лист xlsx (excelize.TotalRows строк вместе с заголовком).
+var ExportTooLarge = apperror.Unprocessable("export_too_large", "export exceeds the xlsx row limit, narrow the period or use csv")
+
+var exportHeader = []string{
+	"reception_id", "pvz_id", "city", "reception_datetime", "reception_status",
+	"product_id", "product_type", "acceptance_datetime",
+	"timezone", "reception_datetime_local", "acceptance_datetime_local",
+}
+
+type ReportService interface {
+	GetStats(req *request.Stats) (*response.Stats, error)
+	ExportReceptions(req *request.Export, fn func(row *response.ExportRow) error) error
+	GetDailyReport(date time.Time) ([]response.DailyReport, error)
+}
+
+func (h *Handler) GetStats(_ context.Context, req openapi.GetStatsRequestObject) (openapi.GetStatsResponseObject, error) {
+	log.SetPrefix("handler.GetStats")
+
+	stats, err := h.reportService.GetStats(&request.Stats{
+		StartDate: req.Params.StartDate,
+		EndDate:   req.Params.EndDate,
+		City:      req.Params.City,
+		PvzId:     req.Params.PvzId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.GetStats200JSONResponse(*stats), nil
+}
+
+func (h *Handler) GetExportReceptionsCsv(_ context.Context, req openapi.GetExportReceptionsCsvRequestObject) (openapi.GetExportReceptionsCsvResponseObject, error) {
+	log.SetPrefix("handler.GetExportReceptionsCsv")
+
+	return csvExport{
+		reportService: h.reportService,
+		req: &request.Export{
+			StartDate: req.Params.StartDate,
+			EndDate:   req.Params.EndDate,
+			City:      req.Params.City,
+		},
+	}, nil
+}
+
+// csvExport отдает выгрузку потоком, не собирая файл в памяти. Заголовки отправляются
+// только с первой строкой, чтобы ошибку запроса еще можно было вернуть как problem+json.
+// Об ошибке после первой строки сообщает трейлер ExportStatusTrailer.
+type csvExport struct {
+	reportService ReportService
+	req           *request.Export
+}
+
+func (e csvExport) VisitGetExportReceptionsCsvResponse(rw http.ResponseWriter) error {
+	var w *csv.Writer
+	start := func() error {
+		rw.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		rw.Header().Set("Content-Disposition", csvDisposition)
+		rw.Header().Set("Trailer", ExportStatusTrailer)
+		rw.WriteHeader(200)
+
+		w = csv.NewWriter(rw)
+
+		return w.Write(exportHeader)
+	}
+
+	rowsWritten := 0
+	err := e.reportService.ExportReceptions(e.req, func(row *response.ExportRow) error {
+		if w == nil {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+
+		if err := w.Write(exportRecord(row)); err != nil {
+			return err
+		}
+
+		rowsWritten++
+		if rowsWritten%csvFlushRows == 0 {
+			w.Flush()
+			if f, ok := rw.(http.Flusher); ok {
+				f.Flush()
+			}
+		}
+
+		return w.Error()
+	})
+	if err != nil && w == nil {
+		return err
+	}
+
+	if w == nil {
+		err = start()
+	}
+
+	w.Flush()
+	if err == nil {
+		err = w.Error()
+	}
+
+	status := ExportStatusComplete
+	if err != nil {
+		log.Printf("error: %v", err)
+		status = ExportStatusFailed
+	}
+	rw.Header().Set(ExportStatusTrailer, status)
+
+	return nil
+}
+
+func (h *Handler) GetExportReceptionsXlsx(_ context.Context, req openapi.GetExportReceptionsXlsxRequestObject) (openapi.GetExportReceptionsXlsxResponseObject, error) {
+	log.SetPrefix("handler.GetExportReceptionsXlsx")
+
+	return xlsxExport{
+		reportService: h.reportService,
+		req: &request.Export{
+			StartDate: req.Params.StartDate,
+			EndDate:   req.Params.EndDate,
+			City:      req.Params.City,
+		},
+	}, nil
+}
+
+// xlsxExport отдает книгу, не собирая ее в памяти: StreamWriter сбрасывает строки листа
+// во временный файл, а f.Write пишет архив прямо в ответ. Пока книга не начала писаться,
+// ошибку еще можно вернуть как problem+json.
+type xlsxExport struct {
+	reportService ReportService
+	req           *request.Export
+}
+
+func (e xlsxExport) VisitGetExportReceptionsXlsxResponse(rw http.ResponseWriter) error {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	sw, err := f.NewStreamWriter(f.GetSheetName(0))
+	if err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+
+	if err = sw.SetRow("A1", toCells(exportHeader)); err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+
+	rowNum := 1
+	err = e.reportService.ExportReceptions(e.req, func(row *response.ExportRow) error {
+		if rowNum == excelize.TotalRows {
+			return ExportTooLarge
+		}
+		rowNum++
+
+		cell, err := excelize.CoordinatesToCellName(1, rowNum)
+		if err != nil {
+			return err
+		}
+
+		return sw.SetRow(cell, toCells(exportRecord(row)))
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = sw.Flush(); err != nil {
+		log.Printf("error: %v", err)
+		return err
+	}
+
+	rw.Header().Set("Content-Type", xlsxContentType)
+	rw.Header().Set("Content-Disposition", xlsxDisposition)
+	rw.WriteHeader(200)
+
+	if err = f.Write(rw); err != nil {
+		log.Printf("error: %v", err)
+	}
+
+	return nil
+}
+
+func (h *Handler) GetReportsDaily(_ context.Context, req openapi.GetReportsDailyRequestObject) (openapi.GetReportsDailyResponseObject, error) {
+	log.SetPrefix("handler.GetReportsDaily")
+
+	report, err := h.reportService.GetDailyReport(req.Params.Date.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.GetReportsDaily200JSONResponse(report), nil
+}
+
+func exportRecord(row *response.ExportRow) []string {
+	record := []string{
+		row.ReceptionId.String(),
+		row.PvzId.String(),
+		row.City,
+		row.ReceptionDateTime.UTC().Format(time.RFC3339),
+		row.ReceptionStatus,
+		"", "", "",
+		row.Timezone, formatLocalTime(row.ReceptionDateTime, row.Timezone), "",
+	}
+
+	if row.ProductId != nil {
+		record[5] = row.ProductId.String()
+		record[6] = *row.ProductType
+		record[7] = row.ProductDateTime.UTC().Format(time.RFC3339)
+		record[10] = formatLocalTime(*row.ProductDateTime, row.Timezone)
+	}
+
+	return record
+}
+
+func formatLocalTime(t time.Time, timezone string) string {
+	local := entity.LocalTime(t, timezone)
+	if local == nil {
+		return ""
+	}
+
+	return local.Format(time.RFC3339)
+}
+
+func toCells(record []string) []interface{} {
+	cells := make([]interface{}, len(record))
+	for i, v := range record {
+		cells[i] = v
+	}
+
+	return cells
+}
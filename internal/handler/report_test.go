@@ -0,0 +1,241 @@
+package handler_test
+
+import (
+	"encoding/csv"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/handler"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/alexey-shedrin/avito-test-task/internal/service"
+	"github.com/alexey-shedrin/avito-test-task/internal/service/mocks"
+	"github.com/alexey-shedrin/avito-test-task/internal/utils/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetStats_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReportService(ctrl)
+	h := handler.New(handler.Services{Report: mockService})
+
+	mockService.EXPECT().GetStats(gomock.Any()).DoAndReturn(func(req *request.Stats) (*response.Stats, error) {
+		require.NotNil(t, req.City)
+		require.Equal(t, "Казань", *req.City)
+		return &response.Stats{}, nil
+	})
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodGet, "/stats?city=%D0%9A%D0%B0%D0%B7%D0%B0%D0%BD%D1%8C", nil)
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestGetStats_Forbidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := handler.New(handler.Services{Report: mocks.NewMockReportService(ctrl)})
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodGet, "/stats", nil)
+	jwt, _ := token.GenerateJWT("client")
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestGetExportReceptionsCsv_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReportService(ctrl)
+	h := handler.New(handler.Services{Report: mockService})
+
+	productID := uuid.New()
+	productType := "обувь"
+	now := time.Now()
+
+	mockService.EXPECT().ExportReceptions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(req *request.Export, fn func(row *response.ExportRow) error) error {
+			if err := fn(&response.ExportRow{
+				ReceptionId:       uuid.New(),
+				PvzId:             uuid.New(),
+				City:              "Москва",
+				Timezone:          "Europe/Moscow",
+				ReceptionDateTime: now,
+				ReceptionStatus:   "closed",
+				ProductId:         &productID,
+				ProductType:       &productType,
+				ProductDateTime:   &now,
+			}); err != nil {
+				return err
+			}
+			return fn(&response.ExportRow{ReceptionId: uuid.New(), PvzId: uuid.New(), City: "Москва"})
+		})
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodGet, "/export/receptions.csv", nil)
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Header().Get("Content-Type"), "text/csv")
+
+	records, err := csv.NewReader(w.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	require.Equal(t, "reception_id", records[0][0])
+	require.Equal(t, productID.String(), records[1][5])
+	require.Equal(t, "Europe/Moscow", records[1][8])
+	require.True(t, strings.HasSuffix(records[1][9], "+03:00"))
+	require.Equal(t, "", records[2][9])
+	require.Equal(t, "", records[2][5])
+	require.Equal(t, handler.ExportStatusComplete, w.Result().Trailer.Get(handler.ExportStatusTrailer))
+}
+
+func TestGetExportReceptionsCsv_FailsMidStream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReportService(ctrl)
+	h := handler.New(handler.Services{Report: mockService})
+
+	mockService.EXPECT().ExportReceptions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(req *request.Export, fn func(row *response.ExportRow) error) error {
+			if err := fn(&response.ExportRow{ReceptionId: uuid.New(), PvzId: uuid.New(), City: "Москва"}); err != nil {
+				return err
+			}
+			return errors.New("connection reset")
+		})
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodGet, "/export/receptions.csv", nil)
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, handler.ExportStatusFailed, w.Result().Trailer.Get(handler.ExportStatusTrailer))
+}
+
+func TestGetExportReceptionsCsv_InvalidDateRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReportService(ctrl)
+	h := handler.New(handler.Services{Report: mockService})
+
+	mockService.EXPECT().ExportReceptions(gomock.Any(), gomock.Any()).Return(service.InvalidDateRange)
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodGet, "/export/receptions.csv", nil)
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGetExportReceptionsXlsx_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReportService(ctrl)
+	h := handler.New(handler.Services{Report: mockService})
+
+	mockService.EXPECT().ExportReceptions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(req *request.Export, fn func(row *response.ExportRow) error) error {
+			return fn(&response.ExportRow{ReceptionId: uuid.New(), PvzId: uuid.New(), City: "Казань"})
+		})
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodGet, "/export/receptions.xlsx", nil)
+	jwt, _ := token.GenerateJWT(entity.EmployeeRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	f, err := excelize.OpenReader(w.Body)
+	require.NoError(t, err)
+	defer f.Close()
+
+	rows, err := f.GetRows(f.GetSheetName(0))
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.Equal(t, "Казань", rows[1][2])
+}
+
+func TestGetReportsDaily_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockReportService(ctrl)
+	h := handler.New(handler.Services{Report: mockService})
+
+	date := time.Date(2025, 4, 20, 0, 0, 0, 0, time.UTC)
+	mockService.EXPECT().GetDailyReport(date).Return([]response.DailyReport{{Date: "2025-04-20", City: "Москва"}}, nil)
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodGet, "/reports/daily?date=2025-04-20", nil)
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestGetReportsDaily_MissingDate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := handler.New(handler.Services{Report: mocks.NewMockReportService(ctrl)})
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodGet, "/reports/daily", nil)
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"context"
+	"log"
+
+	openapi "github.com/alexey-shedrin/avito-test-task/internal/gen"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/google/uuid"
+)
+
+var InvalidShipmentId = apperror.Validation("invalid_shipment_id", "invalid shipment id")
+
+type ShipmentService interface {
+	CreateShipment(shipment *entity.Shipment) (*entity.Shipment, error)
+	GetShipment(shipmentID uuid.UUID) (*entity.Shipment, []*entity.Product, error)
+	AddProduct(shipmentID, productID uuid.UUID) (*entity.Product, error)
+	DeleteProduct(shipmentID, productID uuid.UUID) error
+	CloseLastShipment(pvzID uuid.UUID) (*entity.Shipment, error)
+}
+
+func (h *Handler) PostShipments(_ context.Context, req openapi.PostShipmentsRequestObject) (openapi.PostShipmentsResponseObject, error) {
+	log.SetPrefix("handler.PostShipments")
+
+	shipment, err := h.shipmentService.CreateShipment(&entity.Shipment{PvzId: req.Body.PvzId})
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.PostShipments201JSONResponse(shipment.ToResponse()), nil
+}
+
+func (h *Handler) GetShipmentsShipmentId(_ context.Context, req openapi.GetShipmentsShipmentIdRequestObject) (openapi.GetShipmentsShipmentIdResponseObject, error) {
+	log.SetPrefix("handler.GetShipmentsShipmentId")
+
+	if req.ShipmentId == uuid.Nil {
+		return nil, InvalidShipmentId
+	}
+
+	shipment, products, err := h.shipmentService.GetShipment(req.ShipmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.GetShipmentsShipmentId200JSONResponse(response.ShipmentWithProducts{
+		Shipment: shipment.ToResponse(),
+		Products: productsResponse(products),
+	}), nil
+}
+
+func (h *Handler) PostShipmentsShipmentIdProducts(_ context.Context, req openapi.PostShipmentsShipmentIdProductsRequestObject) (openapi.PostShipmentsShipmentIdProductsResponseObject, error) {
+	log.SetPrefix("handler.PostShipmentsShipmentIdProducts")
+
+	if req.ShipmentId == uuid.Nil {
+		return nil, InvalidShipmentId
+	}
+
+	product, err := h.shipmentService.AddProduct(req.ShipmentId, req.Body.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.PostShipmentsShipmentIdProducts200JSONResponse(*product.ToResponse()), nil
+}
+
+func (h *Handler) DeleteShipmentsShipmentIdProductsProductId(_ context.Context, req openapi.DeleteShipmentsShipmentIdProductsProductIdRequestObject) (openapi.DeleteShipmentsShipmentIdProductsProductIdResponseObject, error) {
+	log.SetPrefix("handler.DeleteShipmentsShipmentIdProductsProductId")
+
+	if req.ShipmentId == uuid.Nil {
+		return nil, InvalidShipmentId
+	}
+
+	if req.ProductId == uuid.Nil {
+		return nil, InvalidProductId
+	}
+
+	if err := h.shipmentService.DeleteProduct(req.ShipmentId, req.ProductId); err != nil {
+		return nil, err
+	}
+
+	return openapi.DeleteShipmentsShipmentIdProductsProductId204Response{}, nil
+}
+
+func (h *Handler) PostPvzPvzIdCloseLastShipment(_ context.Context, req openapi.PostPvzPvzIdCloseLastShipmentRequestObject) (openapi.PostPvzPvzIdCloseLastShipmentResponseObject, error) {
+	log.SetPrefix("handler.PostPvzPvzIdCloseLastShipment")
+
+	if req.PvzId == uuid.Nil {
+		return nil, InvalidPvzId
+	}
+
+	shipment, err := h.shipmentService.CloseLastShipment(req.PvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.PostPvzPvzIdCloseLastShipment200JSONResponse(shipment.ToResponse()), nil
+}
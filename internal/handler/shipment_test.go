@@ -0,0 +1,123 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	openapi "github.com/alexey-shedrin/avito-test-task/internal/gen"
+	"github.com/alexey-shedrin/avito-test-task/internal/handler"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/alexey-shedrin/avito-test-task/internal/service"
+	"github.com/alexey-shedrin/avito-test-task/internal/service/mocks"
+	"github.com/alexey-shedrin/avito-test-task/internal/utils/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPostShipments_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockShipmentService(ctrl)
+	h := handler.New(handler.Services{Shipment: mockService})
+
+	pvzID := uuid.New()
+	mockService.EXPECT().CreateShipment(&entity.Shipment{PvzId: pvzID}).
+		Return(&entity.Shipment{Id: uuid.New(), PvzId: pvzID, Status: entity.ShipmentStatusInProgress}, nil)
+
+	r := setupRouter(t, h)
+
+	body, _ := json.Marshal(openapi.PostShipmentsJSONBody{PvzId: pvzID})
+	req := httptest.NewRequest(http.MethodPost, "/shipments", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	jwt, _ := token.GenerateJWT(entity.EmployeeRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var resp response.Shipment
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, entity.ShipmentStatusInProgress, resp.Status)
+}
+
+func TestPostShipmentsShipmentIdProducts_AlreadyAttached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockShipmentService(ctrl)
+	h := handler.New(handler.Services{Shipment: mockService})
+
+	shipmentID := uuid.New()
+	productID := uuid.New()
+	mockService.EXPECT().AddProduct(shipmentID, productID).Return(nil, service.ProductInShipment)
+
+	r := setupRouter(t, h)
+
+	body, _ := json.Marshal(openapi.PostShipmentsShipmentIdProductsJSONBody{ProductId: productID})
+	req := httptest.NewRequest(http.MethodPost, "/shipments/"+shipmentID.String()+"/products", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	jwt, _ := token.GenerateJWT(entity.EmployeeRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestPostPvzPvzIdCloseLastShipment_ModeratorForbidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := handler.New(handler.Services{Shipment: mocks.NewMockShipmentService(ctrl)})
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodPost, "/pvz/"+uuid.New().String()+"/close_last_shipment", nil)
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestGetShipmentsShipmentId_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := mocks.NewMockShipmentService(ctrl)
+	h := handler.New(handler.Services{Shipment: mockService})
+
+	shipmentID := uuid.New()
+	mockService.EXPECT().GetShipment(shipmentID).Return(
+		&entity.Shipment{Id: shipmentID, Status: entity.ShipmentStatusInProgress},
+		[]*entity.Product{{Id: uuid.New(), Status: entity.ProductStatusStored, ShipmentId: &shipmentID}},
+		nil,
+	)
+
+	r := setupRouter(t, h)
+
+	req := httptest.NewRequest(http.MethodGet, "/shipments/"+shipmentID.String(), nil)
+	jwt, _ := token.GenerateJWT(entity.ModeratorRole)
+	req.Header.Set("Authorization", jwt)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp response.ShipmentWithProducts
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp.Products, 1)
+	require.Equal(t, shipmentID, *resp.Products[0].ShipmentId)
+}
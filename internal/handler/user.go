@@ -1,18 +1,13 @@
 package handler
 
 import (
+	"context"
 	"log"
-	"strings"
 
+	openapi "github.com/alexey-shedrin/avito-test-task/internal/gen"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
-	"github.com/gin-gonic/gin"
-)
-
-const (
-	InvalidRole  = "invalid role"
-	InvalidEmail = "invalid email"
 )
 
 type UserService interface {
@@ -21,77 +16,42 @@ type UserService interface {
 	Login(request *request.Login) (*response.Login, error)
 }
 
-func (h *Handler) PostDummyLogin(c *gin.Context) {
+func (h *Handler) PostDummyLogin(_ context.Context, req openapi.PostDummyLoginRequestObject) (openapi.PostDummyLoginResponseObject, error) {
 	log.SetPrefix("handler.PostDummyLogin")
-	var req request.DummyLogin
-	if err := c.ShouldBindJSON(&req); err != nil {
-		if strings.Contains(err.Error(), "Field validation") {
-			c.JSON(400, gin.H{"error": InvalidRole})
-
-			return
-		}
-
-		log.Printf("error: %v", err)
-		c.JSON(400, gin.H{"error": err.Error()})
 
-		return
-	}
-
-	resp, err := h.userService.DummyLogin(&req)
+	resp, err := h.userService.DummyLogin(&request.DummyLogin{Role: string(req.Body.Role)})
 	if err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
-		return
+		return nil, err
 	}
 
-	c.JSON(200, resp)
+	return openapi.PostDummyLogin200JSONResponse{Token: resp.Token}, nil
 }
 
-func (h *Handler) PostRegister(c *gin.Context) {
+func (h *Handler) PostRegister(_ context.Context, req openapi.PostRegisterRequestObject) (openapi.PostRegisterResponseObject, error) {
 	log.SetPrefix("handler.PostRegister")
-	var req request.Register
-	if err := c.ShouldBindJSON(&req); err != nil {
-		if strings.Contains(err.Error(), "Field validation") {
-			c.JSON(400, gin.H{"error": InvalidRole})
-
-			return
-		}
 
-		log.Printf("error: %v", err)
-		c.JSON(400, gin.H{"error": err.Error()})
-
-		return
-	}
-
-	user, err := h.userService.Register(&req)
+	user, err := h.userService.Register(&request.Register{
+		Email:    string(req.Body.Email),
+		Password: req.Body.Password,
+		Role:     string(req.Body.Role),
+	})
 	if err != nil {
-		c.JSON(400, gin.H{"error": err.Error()})
-		return
+		return nil, err
 	}
 
-	c.JSON(201, user.ToResponse())
+	return openapi.PostRegister201JSONResponse(*user.ToResponse()), nil
 }
 
-func (h *Handler) PostLogin(c *gin.Context) {
+func (h *Handler) PostLogin(_ context.Context, req openapi.PostLoginRequestObject) (openapi.PostLoginResponseObject, error) {
 	log.SetPrefix("handler.PostLogin")
-	var req request.Login
-	if err := c.ShouldBindJSON(&req); err != nil {
-		if strings.Contains(err.Error(), "Field validation") {
-			c.JSON(400, gin.H{"error": InvalidEmail})
-
-			return
-		}
-
-		log.Printf("error: %v", err)
-		c.JSON(400, gin.H{"error": err.Error()})
-
-		return
-	}
 
-	resp, err := h.userService.Login(&req)
+	resp, err := h.userService.Login(&request.Login{
+		Email:    string(req.Body.Email),
+		Password: req.Body.Password,
+	})
 	if err != nil {
-		c.JSON(401, gin.H{"error": err.Error()})
-		return
+		return nil, err
 	}
 
-	c.JSON(200, resp)
+	return openapi.PostLogin200JSONResponse{Token: resp.Token}, nil
 }
@@ -3,7 +3,6 @@ package handler_test
 import (
 	"bytes"
 	"encoding/json"
-	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -12,25 +11,18 @@ import (
 	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/alexey-shedrin/avito-test-task/internal/service"
 	"github.com/alexey-shedrin/avito-test-task/internal/service/mocks"
-	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
-func setupUserRouter(h *handler.Handler, setup func(*gin.Engine)) *gin.Engine {
-	gin.SetMode(gin.TestMode)
-	r := gin.New()
-	setup(r)
-	return r
-}
-
 func TestPostDummyLogin_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockUser := mocks.NewMockUserService(ctrl)
-	h := handler.New(mockUser, nil, nil)
+	h := handler.New(handler.Services{User: mockUser})
 
 	input := request.DummyLogin{Role: "moderator"}
 	expected := &response.DummyLogin{Token: "token"}
@@ -39,11 +31,9 @@ func TestPostDummyLogin_Success(t *testing.T) {
 
 	body, _ := json.Marshal(input)
 
-	r := setupUserRouter(h, func(r *gin.Engine) {
-		r.POST("/dummy-login", h.PostDummyLogin)
-	})
+	r := setupRouter(t, h)
 
-	req := httptest.NewRequest(http.MethodPost, "/dummy-login", bytes.NewReader(body))
+	req := httptest.NewRequest(http.MethodPost, "/dummyLogin", bytes.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
 	w := httptest.NewRecorder()
 
@@ -56,13 +46,11 @@ func TestPostDummyLogin_InvalidJSON(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	h := handler.New(mocks.NewMockUserService(ctrl), nil, nil)
+	h := handler.New(handler.Services{User: mocks.NewMockUserService(ctrl)})
 
-	r := setupUserRouter(h, func(r *gin.Engine) {
-		r.POST("/dummy-login", h.PostDummyLogin)
-	})
+	r := setupRouter(t, h)
 
-	req := httptest.NewRequest(http.MethodPost, "/dummy-login", bytes.NewReader([]byte("{bad json")))
+	req := httptest.NewRequest(http.MethodPost, "/dummyLogin", bytes.NewReader([]byte("{bad json")))
 	req.Header.Set("Content-Type", "application/json")
 	w := httptest.NewRecorder()
 
@@ -76,7 +64,7 @@ func TestPostRegister_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUser := mocks.NewMockUserService(ctrl)
-	h := handler.New(mockUser, nil, nil)
+	h := handler.New(handler.Services{User: mockUser})
 
 	input := request.Register{Email: "test@example.com", Password: "pass", Role: "employee"}
 	expected := &entity.User{Email: input.Email, Role: input.Role}
@@ -85,9 +73,7 @@ func TestPostRegister_Success(t *testing.T) {
 
 	body, _ := json.Marshal(input)
 
-	r := setupUserRouter(h, func(r *gin.Engine) {
-		r.POST("/register", h.PostRegister)
-	})
+	r := setupRouter(t, h)
 
 	req := httptest.NewRequest(http.MethodPost, "/register", bytes.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
@@ -102,11 +88,9 @@ func TestPostRegister_InvalidRole(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	h := handler.New(mocks.NewMockUserService(ctrl), nil, nil)
+	h := handler.New(handler.Services{User: mocks.NewMockUserService(ctrl)})
 
-	r := setupUserRouter(h, func(r *gin.Engine) {
-		r.POST("/register", h.PostRegister)
-	})
+	r := setupRouter(t, h)
 
 	badJSON := []byte(`{"email": "user", "password": "123"}`) // role пустой
 
@@ -124,7 +108,7 @@ func TestPostLogin_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUser := mocks.NewMockUserService(ctrl)
-	h := handler.New(mockUser, nil, nil)
+	h := handler.New(handler.Services{User: mockUser})
 
 	input := request.Login{Email: "user@mail.com", Password: "secret"}
 	expected := &response.Login{Token: "jwt"}
@@ -133,9 +117,7 @@ func TestPostLogin_Success(t *testing.T) {
 
 	body, _ := json.Marshal(input)
 
-	r := setupUserRouter(h, func(r *gin.Engine) {
-		r.POST("/login", h.PostLogin)
-	})
+	r := setupRouter(t, h)
 
 	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
@@ -150,11 +132,9 @@ func TestPostLogin_InvalidEmail(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	h := handler.New(mocks.NewMockUserService(ctrl), nil, nil)
+	h := handler.New(handler.Services{User: mocks.NewMockUserService(ctrl)})
 
-	r := setupUserRouter(h, func(r *gin.Engine) {
-		r.POST("/login", h.PostLogin)
-	})
+	r := setupRouter(t, h)
 
 	badJSON := []byte(`{"password": "123"}`) // без email
 
@@ -172,18 +152,15 @@ func TestPostLogin_Unauthorized(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUser := mocks.NewMockUserService(ctrl)
-	h := handler.New(mockUser, nil, nil)
+	h := handler.New(handler.Services{User: mockUser})
 
 	input := request.Login{Email: "wrong@mail.com", Password: "wrong"}
-	errMsg := "unauthorized"
 
-	mockUser.EXPECT().Login(&input).Return(nil, errors.New(errMsg))
+	mockUser.EXPECT().Login(&input).Return(nil, service.InvalidCredentials)
 
 	body, _ := json.Marshal(input)
 
-	r := setupUserRouter(h, func(r *gin.Engine) {
-		r.POST("/login", h.PostLogin)
-	})
+	r := setupRouter(t, h)
 
 	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewReader(body))
 	req.Header.Set("Content-Type", "application/json")
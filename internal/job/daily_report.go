@@ -0,0 +1,80 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/config"
+)
+
+type DailyReportService interface {
+	BuildDailyReport(date time.Time) error
+	BackfillDailyReports(from, to time.Time) error
+}
+
+// DailyReport раз в сутки в заданное время (UTC) строит сводку за предыдущий день по UTC.
+// Границы дня для каждого ПВЗ репозиторий берет в часовом поясе его города.
+type DailyReport struct {
+	reportService DailyReportService
+	hour          int
+	minute        int
+	backfillDays  int
+}
+
+func NewDailyReport(reportService DailyReportService, cfg config.DailyReport) (*DailyReport, error) {
+	at, err := time.Parse("15:04", cfg.Time)
+	if err != nil {
+		return nil, fmt.Errorf("invalid daily report time %q: %w", cfg.Time, err)
+	}
+
+	return &DailyReport{
+		reportService: reportService,
+		hour:          at.Hour(),
+		minute:        at.Minute(),
+		backfillDays:  cfg.BackfillDays,
+	}, nil
+}
+
+func (j *DailyReport) Run(ctx context.Context) {
+	log.SetPrefix("job.DailyReport")
+
+	if j.backfillDays > 0 {
+		yesterday := startOfDay(time.Now().UTC()).AddDate(0, 0, -1)
+		from := yesterday.AddDate(0, 0, -(j.backfillDays - 1))
+		if err := j.reportService.BackfillDailyReports(from, yesterday); err != nil {
+			log.Printf("error: backfill: %v", err)
+		}
+	}
+
+	for {
+		timer := time.NewTimer(time.Until(j.NextRun(time.Now())))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+			day := startOfDay(time.Now().UTC()).AddDate(0, 0, -1)
+			if err := j.reportService.BuildDailyReport(day); err != nil {
+				log.Printf("error: %v", err)
+			}
+		}
+	}
+}
+
+// NextRun возвращает ближайший после now момент запуска.
+func (j *DailyReport) NextRun(now time.Time) time.Time {
+	now = now.UTC()
+	next := time.Date(now.Year(), now.Month(), now.Day(), j.hour, j.minute, 0, 0, time.UTC)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+
+	return next
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
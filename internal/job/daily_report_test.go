@@ -0,0 +1,39 @@
+package job_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/config"
+	"github.com/alexey-shedrin/avito-test-task/internal/job"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDailyReport_NextRun(t *testing.T) {
+	j, err := job.NewDailyReport(nil, config.DailyReport{Time: "01:30"})
+	require.NoError(t, err)
+
+	t.Run("Later today", func(t *testing.T) {
+		now := time.Date(2025, 4, 20, 0, 15, 0, 0, time.UTC)
+
+		require.Equal(t, time.Date(2025, 4, 20, 1, 30, 0, 0, time.UTC), j.NextRun(now))
+	})
+
+	t.Run("Tomorrow", func(t *testing.T) {
+		now := time.Date(2025, 4, 20, 1, 30, 0, 0, time.UTC)
+
+		require.Equal(t, time.Date(2025, 4, 21, 1, 30, 0, 0, time.UTC), j.NextRun(now))
+	})
+
+	t.Run("Local time", func(t *testing.T) {
+		now := time.Date(2025, 4, 20, 3, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+
+		require.True(t, time.Date(2025, 4, 20, 1, 30, 0, 0, time.UTC).Equal(j.NextRun(now)))
+	})
+}
+
+func TestNewDailyReport_InvalidTime(t *testing.T) {
+	_, err := job.NewDailyReport(nil, config.DailyReport{Time: "25:00"})
+
+	require.Error(t, err)
+}
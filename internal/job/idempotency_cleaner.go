@@ -0,0 +1,48 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/config"
+)
+
+type ExpiredIdempotencyKeyStore interface {
+	DeleteExpiredIdempotencyKeys(now time.Time) (int64, error)
+}
+
+// IdempotencyCleaner периодически удаляет ключи идемпотентности с истекшим сроком хранения.
+type IdempotencyCleaner struct {
+	store    ExpiredIdempotencyKeyStore
+	interval time.Duration
+}
+
+func NewIdempotencyCleaner(store ExpiredIdempotencyKeyStore, cfg config.Idempotency) (*IdempotencyCleaner, error) {
+	if cfg.CleanupInterval <= 0 {
+		return nil, fmt.Errorf("invalid idempotency cleanup interval %s", cfg.CleanupInterval)
+	}
+
+	return &IdempotencyCleaner{
+		store:    store,
+		interval: cfg.CleanupInterval,
+	}, nil
+}
+
+func (j *IdempotencyCleaner) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := j.store.DeleteExpiredIdempotencyKeys(time.Now().UTC()); err != nil {
+				log.SetPrefix("job.IdempotencyCleaner")
+				log.Printf("error: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package job_test
+
+import (
+	"testing"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/config"
+	"github.com/alexey-shedrin/avito-test-task/internal/job"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIdempotencyCleaner_InvalidInterval(t *testing.T) {
+	_, err := job.NewIdempotencyCleaner(nil, config.Idempotency{})
+
+	require.Error(t, err)
+}
@@ -0,0 +1,48 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/config"
+)
+
+type StaleReceptionService interface {
+	CloseStaleReceptions() (int64, error)
+}
+
+// ReceptionSweeper периодически закрывает приемки, открытые дольше допустимого для ПВЗ времени.
+type ReceptionSweeper struct {
+	receptionService StaleReceptionService
+	interval         time.Duration
+}
+
+func NewReceptionSweeper(receptionService StaleReceptionService, cfg config.ReceptionSweeper) (*ReceptionSweeper, error) {
+	if cfg.Interval <= 0 {
+		return nil, fmt.Errorf("invalid reception sweeper interval %s", cfg.Interval)
+	}
+
+	return &ReceptionSweeper{
+		receptionService: receptionService,
+		interval:         cfg.Interval,
+	}, nil
+}
+
+func (j *ReceptionSweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := j.receptionService.CloseStaleReceptions(); err != nil {
+				log.SetPrefix("job.ReceptionSweeper")
+				log.Printf("error: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package job_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/config"
+	"github.com/alexey-shedrin/avito-test-task/internal/job"
+	"github.com/stretchr/testify/require"
+)
+
+type staleReceptionService struct {
+	calls atomic.Int32
+}
+
+func (s *staleReceptionService) CloseStaleReceptions() (int64, error) {
+	s.calls.Add(1)
+
+	return 0, nil
+}
+
+func TestReceptionSweeper_Run(t *testing.T) {
+	svc := &staleReceptionService{}
+	j, err := job.NewReceptionSweeper(svc, config.ReceptionSweeper{Interval: 5 * time.Millisecond})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		j.Run(ctx)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool { return svc.calls.Load() >= 2 }, time.Second, time.Millisecond)
+
+	cancel()
+	<-done
+}
+
+func TestNewReceptionSweeper_InvalidInterval(t *testing.T) {
+	_, err := job.NewReceptionSweeper(&staleReceptionService{}, config.ReceptionSweeper{})
+
+	require.Error(t, err)
+}
@@ -68,6 +68,18 @@ func DeleteProduct() {
 	addedProductCount.Desc()
 }
 
+var productStatusCount = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "changed.product.status.total",
+		Help: "Total number of Product status transitions by target status",
+	},
+	[]string{"status"},
+)
+
+func ChangeProductStatus(status string) {
+	productStatusCount.WithLabelValues(status).Inc()
+}
+
 func StartMetricsServer(port string) {
 	http.Handle("/metrics", promhttp.Handler())
 	if err := http.ListenAndServe(fmt.Sprintf(":%s", port), nil); err != nil {
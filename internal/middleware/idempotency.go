@@ -0,0 +1,205 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/alexey-shedrin/avito-test-task/internal/utils/httperror"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	maxIdempotencyKeyLength  = 255
+)
+
+// replayedHeaders заголовки ответа, которые сохраняются вместе с телом и повторяются.
+var replayedHeaders = []string{"ETag", "Location"}
+
+var (
+	IdempotencyKeyInvalid    = apperror.Validation("invalid_idempotency_key", "idempotency key must be 1-255 characters long")
+	IdempotencyKeyMismatch   = apperror.Unprocessable("idempotency_key_mismatch", "idempotency key reused with a different request body")
+	IdempotencyKeyInProgress = apperror.Conflict("idempotency_key_in_progress", "request with this idempotency key is still in progress")
+)
+
+type IdempotencyStore interface {
+	AcquireIdempotencyKey(key *entity.IdempotencyKey) (bool, error)
+	GetIdempotencyKey(key *entity.IdempotencyKey) (*entity.IdempotencyKey, error)
+	SaveIdempotencyResponse(key *entity.IdempotencyKey) error
+	ReleaseIdempotencyKey(key *entity.IdempotencyKey) error
+}
+
+// Idempotency повторяет сохраненный ответ на изменяющий запрос с тем же заголовком
+// Idempotency-Key в течение ttl. Ключ привязан к токену вызывающего, чужой ответ
+// не повторяется. Сохраняются только успешные ответы: после ошибки
+// ключ освобождается, и повторный запрос выполняется заново.
+func Idempotency(store IdempotencyStore, ttl time.Duration) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader(IdempotencyKeyHeader)
+		if header == "" || !isMutating(c.Request.Method) {
+			c.Next()
+			return
+		}
+
+		log.SetPrefix("middleware.Idempotency")
+
+		if len(header) > maxIdempotencyKeyLength {
+			httperror.Respond(c, IdempotencyKeyInvalid)
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			httperror.BadRequest(c, err.Error())
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		hash := sha256.Sum256(body)
+		now := time.Now().UTC()
+		key := &entity.IdempotencyKey{
+			Key:         header,
+			Method:      c.Request.Method,
+			Path:        c.Request.URL.Path,
+			Subject:     idempotencySubject(c),
+			RequestHash: hex.EncodeToString(hash[:]),
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(ttl),
+		}
+
+		acquired, err := store.AcquireIdempotencyKey(key)
+		if err != nil {
+			httperror.Respond(c, err)
+
+			return
+		}
+
+		if !acquired {
+			replay(c, store, key)
+			return
+		}
+
+		writer := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		// Ключ не должен оставаться занятым до истечения ttl, даже если обработчик упал.
+		defer func() {
+			if r := recover(); r != nil {
+				release(store, key)
+				panic(r)
+			}
+
+			complete(store, key, writer)
+		}()
+
+		c.Next()
+	}
+}
+
+// complete сохраняет успешный ответ. Если ответ неуспешный или его не удалось сохранить,
+// ключ освобождается.
+func complete(store IdempotencyStore, key *entity.IdempotencyKey, writer *responseRecorder) {
+	status := writer.Status()
+	if status < http.StatusOK || status >= http.StatusMultipleChoices {
+		release(store, key)
+		return
+	}
+
+	key.StatusCode = &status
+	key.ContentType = writer.Header().Get("Content-Type")
+	key.Headers = make(map[string]string)
+	for _, name := range replayedHeaders {
+		if value := writer.Header().Get(name); value != "" {
+			key.Headers[name] = value
+		}
+	}
+	key.ResponseBody = writer.body.Bytes()
+
+	if err := store.SaveIdempotencyResponse(key); err != nil {
+		log.Printf("error: %v", err)
+		release(store, key)
+	}
+}
+
+func release(store IdempotencyStore, key *entity.IdempotencyKey) {
+	if err := store.ReleaseIdempotencyKey(key); err != nil {
+		log.Printf("error: %v", err)
+	}
+}
+
+func replay(c *gin.Context, store IdempotencyStore, key *entity.IdempotencyKey) {
+	stored, err := store.GetIdempotencyKey(key)
+	if err != nil {
+		httperror.Respond(c, err)
+
+		return
+	}
+
+	// Ключ мог освободиться после ошибки параллельного запроса: клиенту стоит повторить попытку.
+	if stored == nil {
+		httperror.Respond(c, IdempotencyKeyInProgress)
+		return
+	}
+
+	if stored.RequestHash != key.RequestHash {
+		httperror.Respond(c, IdempotencyKeyMismatch)
+		return
+	}
+
+	if !stored.Completed() {
+		httperror.Respond(c, IdempotencyKeyInProgress)
+		return
+	}
+
+	for name, value := range stored.Headers {
+		c.Header(name, value)
+	}
+	c.Header(IdempotentReplayedHeader, "true")
+	c.Data(*stored.StatusCode, stored.ContentType, stored.ResponseBody)
+	c.Abort()
+}
+
+// idempotencySubject хеш токена из заголовка Authorization, пустой для анонимного запроса.
+func idempotencySubject(c *gin.Context) string {
+	jwt := strings.TrimPrefix(c.GetHeader(AuthorizationHeader), bearerPrefix)
+	if jwt == "" {
+		return ""
+	}
+
+	hash := sha256.Sum256([]byte(jwt))
+
+	return hex.EncodeToString(hash[:])
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,220 @@
+package middleware_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/middleware"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/alexey-shedrin/avito-test-task/internal/repository/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func setupRouter(store middleware.IdempotencyStore, status int, calls *int) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middleware.Idempotency(store, time.Hour))
+	router.POST("/products", func(c *gin.Context) {
+		*calls++
+		c.Header("ETag", `"1"`)
+		c.JSON(status, gin.H{"id": "product"})
+	})
+
+	return router
+}
+
+func newRequest(key, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/products", bytes.NewBufferString(body))
+	req.Header.Set(middleware.IdempotencyKeyHeader, key)
+	req.Header.Set(middleware.AuthorizationHeader, "Bearer token")
+
+	return req
+}
+
+func TestIdempotency_StoresSuccessfulResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mocks.NewMockIdempotencyStore(ctrl)
+	calls := 0
+	r := setupRouter(store, http.StatusCreated, &calls)
+
+	store.EXPECT().AcquireIdempotencyKey(gomock.Any()).Return(true, nil)
+	store.EXPECT().SaveIdempotencyResponse(gomock.Any()).DoAndReturn(func(key *entity.IdempotencyKey) error {
+		require.Equal(t, "retry-1", key.Key)
+		require.Equal(t, http.StatusCreated, *key.StatusCode)
+		require.JSONEq(t, `{"id":"product"}`, string(key.ResponseBody))
+		require.Equal(t, map[string]string{"ETag": `"1"`}, key.Headers)
+
+		return nil
+	})
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, newRequest("retry-1", `{"type":"обувь"}`))
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Equal(t, 1, calls)
+}
+
+func TestIdempotency_ReplaysStoredResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mocks.NewMockIdempotencyStore(ctrl)
+	calls := 0
+	r := setupRouter(store, http.StatusCreated, &calls)
+
+	var stored entity.IdempotencyKey
+	store.EXPECT().AcquireIdempotencyKey(gomock.Any()).DoAndReturn(func(key *entity.IdempotencyKey) (bool, error) {
+		stored = *key
+		return false, nil
+	})
+	store.EXPECT().GetIdempotencyKey(gomock.Any()).DoAndReturn(
+		func(*entity.IdempotencyKey) (*entity.IdempotencyKey, error) {
+			status := http.StatusCreated
+			stored.StatusCode = &status
+			stored.ContentType = "application/json"
+			stored.Headers = map[string]string{"ETag": `"2"`, "Location": "/products/stored"}
+			stored.ResponseBody = []byte(`{"id":"stored"}`)
+
+			return &stored, nil
+		},
+	)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, newRequest("retry-1", `{"type":"обувь"}`))
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Equal(t, "true", w.Header().Get(middleware.IdempotentReplayedHeader))
+	require.JSONEq(t, `{"id":"stored"}`, w.Body.String())
+	require.Equal(t, `"2"`, w.Header().Get("ETag"))
+	require.Equal(t, "/products/stored", w.Header().Get("Location"))
+	require.Zero(t, calls)
+}
+
+func TestIdempotency_BindsKeyToCaller(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mocks.NewMockIdempotencyStore(ctrl)
+	calls := 0
+	r := setupRouter(store, http.StatusCreated, &calls)
+
+	var subjects []string
+	store.EXPECT().AcquireIdempotencyKey(gomock.Any()).DoAndReturn(func(key *entity.IdempotencyKey) (bool, error) {
+		subjects = append(subjects, key.Subject)
+		return true, nil
+	}).Times(2)
+	store.EXPECT().SaveIdempotencyResponse(gomock.Any()).Return(nil).Times(2)
+
+	first := newRequest("retry-1", `{"type":"обувь"}`)
+	r.ServeHTTP(httptest.NewRecorder(), first)
+
+	second := newRequest("retry-1", `{"type":"обувь"}`)
+	second.Header.Set(middleware.AuthorizationHeader, "Bearer other")
+	r.ServeHTTP(httptest.NewRecorder(), second)
+
+	require.Len(t, subjects, 2)
+	require.NotEmpty(t, subjects[0])
+	require.NotEqual(t, subjects[0], subjects[1])
+	require.NotContains(t, subjects[0], "token")
+	require.Equal(t, 2, calls)
+}
+
+func TestIdempotency_DifferentBody(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mocks.NewMockIdempotencyStore(ctrl)
+	calls := 0
+	r := setupRouter(store, http.StatusCreated, &calls)
+
+	status := http.StatusCreated
+	store.EXPECT().AcquireIdempotencyKey(gomock.Any()).Return(false, nil)
+	store.EXPECT().GetIdempotencyKey(gomock.Any()).
+		Return(&entity.IdempotencyKey{RequestHash: "other", StatusCode: &status}, nil)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, newRequest("retry-1", `{"type":"обувь"}`))
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	require.Zero(t, calls)
+}
+
+func TestIdempotency_ReleasesKeyOnError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mocks.NewMockIdempotencyStore(ctrl)
+	calls := 0
+	r := setupRouter(store, http.StatusBadRequest, &calls)
+
+	store.EXPECT().AcquireIdempotencyKey(gomock.Any()).Return(true, nil)
+	store.EXPECT().ReleaseIdempotencyKey(gomock.Any()).Return(nil)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, newRequest("retry-1", `{"type":"обувь"}`))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Equal(t, 1, calls)
+}
+
+func TestIdempotency_ReleasesKeyOnPanic(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mocks.NewMockIdempotencyStore(ctrl)
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(gin.Recovery(), middleware.Idempotency(store, time.Hour))
+	r.POST("/products", func(c *gin.Context) {
+		panic("boom")
+	})
+
+	store.EXPECT().AcquireIdempotencyKey(gomock.Any()).Return(true, nil)
+	store.EXPECT().ReleaseIdempotencyKey(gomock.Any()).Return(nil)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, newRequest("retry-1", `{"type":"обувь"}`))
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestIdempotency_ReleasesKeyOnSaveError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	store := mocks.NewMockIdempotencyStore(ctrl)
+	calls := 0
+	r := setupRouter(store, http.StatusCreated, &calls)
+
+	store.EXPECT().AcquireIdempotencyKey(gomock.Any()).Return(true, nil)
+	store.EXPECT().SaveIdempotencyResponse(gomock.Any()).Return(errors.New("db is down"))
+	store.EXPECT().ReleaseIdempotencyKey(gomock.Any()).Return(nil)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, newRequest("retry-1", `{"type":"обувь"}`))
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Equal(t, 1, calls)
+}
+
+func TestIdempotency_WithoutKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	calls := 0
+	r := setupRouter(mocks.NewMockIdempotencyStore(ctrl), http.StatusCreated, &calls)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, newRequest("", `{}`))
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Equal(t, 1, calls)
+}
@@ -1,9 +1,10 @@
 package middleware
 
 import (
-	"log"
+	"net/http"
 
-	"github.com/alexey-shedrin/avito-test-task/internal/utils/token"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
+	"github.com/alexey-shedrin/avito-test-task/internal/utils/httperror"
 	"github.com/gin-gonic/gin"
 )
 
@@ -12,24 +13,27 @@ const (
 	jwtSecret           = "secretKey"
 )
 
-func Auth(roles ...string) gin.HandlerFunc {
+var (
+	InvalidToken = apperror.Unauthorized("invalid_token", "invalid token")
+	Forbidden    = apperror.Forbidden("forbidden", "access denied")
+)
+
+// Errors отвечает ошибкой, которую обработчик строгого сервера передал в контекст gin,
+// если ответ еще не записан. Ошибка разбора запроса приходит со статусом 400.
+func Errors() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		log.SetPrefix("middleware.Middleware")
-		jwt := c.GetHeader(AuthorizationHeader)
-		claims, err := token.ValidateJWT(jwt, jwtSecret)
-		if err != nil {
-			c.AbortWithStatusJSON(400, gin.H{"error": err.Error()})
+		c.Next()
 
+		if len(c.Errors) == 0 || c.Writer.Written() {
 			return
 		}
-		role := claims.Role
-		for _, r := range roles {
-			if role == r {
-				c.Next()
-				return
-			}
+
+		err := c.Errors.Last().Err
+		if c.Writer.Status() == http.StatusBadRequest {
+			httperror.BindError(c, err)
+			return
 		}
 
-		c.AbortWithStatusJSON(403, gin.H{"error": "unauthorized"})
+		httperror.Respond(c, err)
 	}
 }
@@ -0,0 +1,232 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
+	"github.com/alexey-shedrin/avito-test-task/internal/utils/httperror"
+	"github.com/alexey-shedrin/avito-test-task/internal/utils/token"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const bearerPrefix = "Bearer "
+
+func init() {
+	openapi3.DefineStringFormatCallback("uuid", func(value string) error {
+		_, err := uuid.Parse(value)
+		return err
+	})
+	openapi3.DefineStringFormat("email", openapi3.FormatOfStringForEmail)
+}
+
+// OpenAPI проверяет запрос по спецификации: требования security операции, параметры и тело.
+// Роли, которым разрешена операция, перечислены в scopes схемы bearerAuth. Запросы к путям
+// вне спецификации пропускаются без проверки.
+func OpenAPI(swagger *openapi3.T) (gin.HandlerFunc, error) {
+	router, err := gorillamux.NewRouter(swagger)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &openapi3filter.Options{
+		MultiError:          true,
+		SkipSettingDefaults: true,
+		AuthenticationFunc:  authenticate,
+	}
+
+	return func(c *gin.Context) {
+		route, pathParams, err := router.FindRoute(c.Request)
+		if err != nil {
+			c.Next()
+			return
+		}
+
+		err = openapi3filter.ValidateRequest(c.Request.Context(), &openapi3filter.RequestValidationInput{
+			Request:    c.Request,
+			PathParams: pathParams,
+			Route:      route,
+			Options:    options,
+		})
+		if err != nil {
+			httperror.Respond(c, validationError(err))
+			return
+		}
+
+		c.Next()
+	}, nil
+}
+
+func authenticate(_ context.Context, input *openapi3filter.AuthenticationInput) error {
+	log.SetPrefix("middleware.authenticate")
+
+	jwt := strings.TrimPrefix(input.RequestValidationInput.Request.Header.Get(AuthorizationHeader), bearerPrefix)
+	claims, err := token.ValidateJWT(jwt, jwtSecret)
+	if err != nil {
+		return InvalidToken
+	}
+
+	if len(input.Scopes) > 0 && !slices.Contains(input.Scopes, claims.Role) {
+		return Forbidden
+	}
+
+	return nil
+}
+
+// validationError переводит ошибку проверки запроса в ошибку каталога. Ошибка авторизации
+// важнее ошибок в параметрах и теле: клиенту без доступа детали запроса не сообщаются.
+func validationError(err error) error {
+	var securityErr *openapi3filter.SecurityRequirementsError
+	if errors.As(err, &securityErr) {
+		for _, e := range securityErr.Errors {
+			var appErr *apperror.Error
+			if errors.As(e, &appErr) {
+				return appErr
+			}
+		}
+
+		return InvalidToken
+	}
+
+	var fields []apperror.FieldError
+	for _, e := range flatten(err) {
+		var requestErr *openapi3filter.RequestError
+		if !errors.As(e, &requestErr) {
+			continue
+		}
+
+		var prefix string
+		if requestErr.Parameter != nil {
+			prefix = requestErr.Parameter.Name
+		}
+
+		schemaErrs := schemaErrors(requestErr.Err)
+		if len(schemaErrs) == 0 {
+			if requestErr.Parameter == nil {
+				return apperror.Validation(apperror.CodeInvalidRequest, requestErr.Error())
+			}
+
+			rule := "type"
+			if errors.Is(requestErr.Err, openapi3filter.ErrInvalidRequired) {
+				rule = "required"
+			}
+
+			fields = append(fields, apperror.FieldError{Field: prefix, Rule: rule})
+
+			continue
+		}
+
+		for _, schemaErr := range schemaErrs {
+			fields = append(fields, apperror.FieldError{
+				Field: fieldPath(prefix, schemaErr.JSONPointer()),
+				Rule:  schemaErr.SchemaField,
+				Param: ruleParam(schemaErr),
+			})
+		}
+	}
+
+	if len(fields) == 0 {
+		return apperror.Validation(apperror.CodeInvalidRequest, err.Error())
+	}
+
+	return httperror.ValidationFailed.WithFields(fields)
+}
+
+func flatten(err error) []error {
+	multi, ok := err.(openapi3.MultiError)
+	if !ok {
+		return []error{err}
+	}
+
+	var errs []error
+	for _, e := range multi {
+		errs = append(errs, flatten(e)...)
+	}
+
+	return errs
+}
+
+func schemaErrors(err error) []*openapi3.SchemaError {
+	var errs []*openapi3.SchemaError
+	for _, e := range flatten(err) {
+		var schemaErr *openapi3.SchemaError
+		if errors.As(e, &schemaErr) {
+			errs = append(errs, schemaErr)
+		}
+	}
+
+	return errs
+}
+
+func fieldPath(prefix string, pointer []string) string {
+	if prefix != "" {
+		pointer = append([]string{prefix}, pointer...)
+	}
+
+	return strings.Join(pointer, ".")
+}
+
+// ruleParam параметр нарушенного правила схемы: допустимые значения, граница или формат.
+func ruleParam(err *openapi3.SchemaError) string {
+	s := err.Schema
+	if s == nil {
+		return ""
+	}
+
+	switch err.SchemaField {
+	case "enum":
+		values := make([]string, len(s.Enum))
+		for i, v := range s.Enum {
+			values[i] = fmt.Sprint(v)
+		}
+
+		return strings.Join(values, " ")
+	case "minimum":
+		return formatFloat(s.Min)
+	case "maximum":
+		return formatFloat(s.Max)
+	case "minLength":
+		return strconv.FormatUint(s.MinLength, 10)
+	case "maxLength":
+		return formatUint(s.MaxLength)
+	case "minItems":
+		return strconv.FormatUint(s.MinItems, 10)
+	case "maxItems":
+		return formatUint(s.MaxItems)
+	case "pattern":
+		return s.Pattern
+	case "format":
+		return s.Format
+	case "type":
+		if s.Type != nil {
+			return strings.Join(s.Type.Slice(), " ")
+		}
+	}
+
+	return ""
+}
+
+func formatFloat(v *float64) string {
+	if v == nil {
+		return ""
+	}
+
+	return strconv.FormatFloat(*v, 'f', -1, 64)
+}
+
+func formatUint(v *uint64) string {
+	if v == nil {
+		return ""
+	}
+
+	return strconv.FormatUint(*v, 10)
+}
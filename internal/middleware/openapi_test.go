@@ -0,0 +1,165 @@
+package middleware_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	openapi "github.com/alexey-shedrin/avito-test-task/internal/gen"
+	"github.com/alexey-shedrin/avito-test-task/internal/middleware"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/alexey-shedrin/avito-test-task/internal/utils/httperror"
+	"github.com/alexey-shedrin/avito-test-task/internal/utils/token"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func setupOpenAPIRouter(t *testing.T, calls *int) *gin.Engine {
+	swagger, err := openapi.GetSwagger()
+	require.NoError(t, err)
+	swagger.Servers = nil
+
+	validator, err := middleware.OpenAPI(swagger)
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(validator)
+
+	handle := func(c *gin.Context) {
+		*calls++
+		c.Status(http.StatusOK)
+	}
+	router.POST("/products/batch", handle)
+	router.GET("/pvz", handle)
+	router.GET("/healthz", handle)
+
+	return router
+}
+
+func newAuthRequest(t *testing.T, method, target, role, body string) *http.Request {
+	req := httptest.NewRequest(method, target, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	if role != "" {
+		jwt, err := token.GenerateJWT(role)
+		require.NoError(t, err)
+		req.Header.Set(middleware.AuthorizationHeader, "Bearer "+jwt)
+	}
+
+	return req
+}
+
+func decodeProblem(t *testing.T, w *httptest.ResponseRecorder) httperror.Problem {
+	require.Equal(t, httperror.ContentType, w.Header().Get("Content-Type"))
+
+	var problem httperror.Problem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &problem))
+
+	return problem
+}
+
+func TestOpenAPI_PassesValidRequest(t *testing.T) {
+	calls := 0
+	r := setupOpenAPIRouter(t, &calls)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, newAuthRequest(t, http.MethodGet, "/pvz?page=2&sort=city", entity.ModeratorRole, ""))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, 1, calls)
+}
+
+func TestOpenAPI_MissingToken(t *testing.T) {
+	calls := 0
+	r := setupOpenAPIRouter(t, &calls)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, newAuthRequest(t, http.MethodGet, "/pvz?limit=100", "", ""))
+
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	require.Equal(t, middleware.InvalidToken.Code, decodeProblem(t, w).Code)
+	require.Zero(t, calls)
+}
+
+func TestOpenAPI_RoleNotInScopes(t *testing.T) {
+	calls := 0
+	r := setupOpenAPIRouter(t, &calls)
+
+	body := `{"pvzId":"` + uuid.NewString() + `","products":[{"type":"обувь"}]}`
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, newAuthRequest(t, http.MethodPost, "/products/batch", entity.ModeratorRole, body))
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+	require.Equal(t, middleware.Forbidden.Code, decodeProblem(t, w).Code)
+	require.Zero(t, calls)
+}
+
+func TestOpenAPI_QueryParameterErrors(t *testing.T) {
+	calls := 0
+	r := setupOpenAPIRouter(t, &calls)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, newAuthRequest(t, http.MethodGet, "/pvz?limit=100&page=abc", entity.EmployeeRole, ""))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	problem := decodeProblem(t, w)
+	require.Equal(t, apperror.CodeValidationFailed, problem.Code)
+	require.ElementsMatch(t, []apperror.FieldError{
+		{Field: "page", Rule: "type"},
+		{Field: "limit", Rule: "maximum", Param: "30"},
+	}, problem.Errors)
+	require.Zero(t, calls)
+}
+
+func TestOpenAPI_BodyErrors(t *testing.T) {
+	calls := 0
+	r := setupOpenAPIRouter(t, &calls)
+
+	body := `{"pvzId":"not-a-uuid","mode":"all","products":[{"type":"обувь"},{"type":"обувь","barcode":"123"}]}`
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, newAuthRequest(t, http.MethodPost, "/products/batch", entity.EmployeeRole, body))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	problem := decodeProblem(t, w)
+	require.Equal(t, apperror.CodeValidationFailed, problem.Code)
+	require.ElementsMatch(t, []apperror.FieldError{
+		{Field: "pvzId", Rule: "format", Param: "uuid"},
+		{Field: "mode", Rule: "enum", Param: "atomic partial"},
+		{Field: "products.1.barcode", Rule: "pattern", Param: `^(\d{13}|PVZ\d{10})$`},
+	}, problem.Errors)
+	require.Zero(t, calls)
+}
+
+func TestOpenAPI_MissingRequiredField(t *testing.T) {
+	calls := 0
+	r := setupOpenAPIRouter(t, &calls)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, newAuthRequest(t, http.MethodPost, "/products/batch", entity.EmployeeRole, `{"products":[]}`))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.ElementsMatch(t, []apperror.FieldError{
+		{Field: "pvzId", Rule: "required"},
+		{Field: "products", Rule: "minItems", Param: "1"},
+	}, decodeProblem(t, w).Errors)
+}
+
+func TestOpenAPI_SkipsUnknownPath(t *testing.T) {
+	calls := 0
+	r := setupOpenAPIRouter(t, &calls)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, 1, calls)
+}
@@ -0,0 +1,123 @@
+package apperror
+
+import "errors"
+
+// Kind класс доменной ошибки. По нему ошибка централизованно переводится в HTTP- и gRPC-статус.
+type Kind string
+
+const (
+	KindValidation         Kind = "validation"
+	KindUnauthorized       Kind = "unauthorized"
+	KindForbidden          Kind = "forbidden"
+	KindNotFound           Kind = "not_found"
+	KindConflict           Kind = "conflict"
+	KindPreconditionFailed Kind = "precondition_failed"
+	KindUnprocessable      Kind = "unprocessable"
+	KindInternal           Kind = "internal"
+)
+
+const (
+	CodeInternal         = "internal"
+	CodeInvalidRequest   = "invalid_request"
+	CodeValidationFailed = "validation_failed"
+	internalMessage      = "internal server error"
+)
+
+// FieldError нарушение правила Rule в поле Field запроса. Param - параметр правила,
+// например допустимые значения для oneof или граница для max.
+type FieldError struct {
+	Field string `json:"field"`
+	Rule  string `json:"rule"`
+	Param string `json:"param,omitempty"`
+}
+
+// Error доменная ошибка каталога. Code - стабильный машинный код для клиента,
+// Message - текст для человека, Details - дополнительные данные об ошибке,
+// Fields - нарушения валидации по полям запроса.
+type Error struct {
+	Kind    Kind
+	Code    string
+	Message string
+	Details map[string]any
+	Fields  []FieldError
+	Err     error
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is сравнивает ошибки по коду, поэтому errors.Is находит ошибку каталога и после WithDetails.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+
+	return ok && t.Code == e.Code
+}
+
+// WithDetails возвращает копию ошибки с дополнительными данными.
+func (e *Error) WithDetails(details map[string]any) *Error {
+	err := *e
+	err.Details = details
+
+	return &err
+}
+
+// WithFields возвращает копию ошибки с нарушениями валидации по полям.
+func (e *Error) WithFields(fields []FieldError) *Error {
+	err := *e
+	err.Fields = fields
+
+	return &err
+}
+
+func New(kind Kind, code, message string) *Error {
+	return &Error{Kind: kind, Code: code, Message: message}
+}
+
+func Validation(code, message string) *Error {
+	return New(KindValidation, code, message)
+}
+
+func Unauthorized(code, message string) *Error {
+	return New(KindUnauthorized, code, message)
+}
+
+func Forbidden(code, message string) *Error {
+	return New(KindForbidden, code, message)
+}
+
+func NotFound(code, message string) *Error {
+	return New(KindNotFound, code, message)
+}
+
+func Conflict(code, message string) *Error {
+	return New(KindConflict, code, message)
+}
+
+func PreconditionFailed(code, message string) *Error {
+	return New(KindPreconditionFailed, code, message)
+}
+
+func Unprocessable(code, message string) *Error {
+	return New(KindUnprocessable, code, message)
+}
+
+// Internal оборачивает непредвиденную ошибку. Ее текст не отдается клиенту.
+func Internal(err error) *Error {
+	return &Error{Kind: KindInternal, Code: CodeInternal, Message: internalMessage, Err: err}
+}
+
+// From приводит err к ошибке каталога. Ошибки вне каталога, в том числе ошибки
+// базы данных, считаются внутренними.
+func From(err error) *Error {
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+
+	return Internal(err)
+}
@@ -1,37 +1,55 @@
 package request
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
 type DummyLogin struct {
-	Role string `json:"role" binding:"required,oneof=employee moderator"`
+	Role string `json:"role"`
 }
 
 type Register struct {
-	Email    string `json:"email" binding:"required,email"`
-	Password string `json:"password" binding:"required"`
-	Role     string `json:"role" binding:"required,oneof=client employee moderator"`
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	Role     string `json:"role"`
 }
 
 type Login struct {
-	Email    string `json:"email" binding:"required,email"`
-	Password string `json:"password" binding:"required"`
+	Email    string `json:"email"`
+	Password string `json:"password"`
 }
 
-type PvzId struct {
-	PvzId uuid.UUID `json:"pvzId" binding:"required"`
+type GetPvz struct {
+	StartDate *time.Time
+	EndDate   *time.Time
+	Page      *int
+	Limit     *int
+	Sort      string
+	Order     string
+	View      string
+	// IncludeArchived добавляет в выборку архивные ПВЗ.
+	IncludeArchived bool
 }
 
-type Pvz struct {
-	City string `json:"city" binding:"required,oneof=Москва Санкт-Петербург Казань"`
+// GetNearbyPvz поиск ПВЗ вокруг точки. Нулевые Radius и Limit заменяются значениями по умолчанию.
+type GetNearbyPvz struct {
+	Latitude  float64
+	Longitude float64
+	Radius    int
+	Limit     int
 }
 
-type Reception struct {
-	PvzId uuid.UUID `json:"pvzId" binding:"required"`
+type Stats struct {
+	StartDate *time.Time
+	EndDate   *time.Time
+	City      *string
+	PvzId     *uuid.UUID
 }
 
-type CreateProduct struct {
-	PvzId uuid.UUID `json:"pvzId" binding:"required"`
-	Type  string    `json:"type" binding:"required,oneof=электроника одежда обувь"`
+type Export struct {
+	StartDate *time.Time
+	EndDate   *time.Time
+	City      *string
 }
@@ -20,31 +20,169 @@ type Login struct {
 }
 
 type Pvz struct {
-	Id               uuid.UUID `json:"id"`
-	City             string    `json:"city"`
-	RegistrationDate time.Time `json:"registrationDate"`
+	Id                      uuid.UUID  `json:"id"`
+	City                    string     `json:"city"`
+	RegistrationDate        time.Time  `json:"registrationDate"`
+	RegistrationDateLocal   *time.Time `json:"registrationDateLocal,omitempty"`
+	Timezone                string     `json:"timezone,omitempty"`
+	Address                 *string    `json:"address,omitempty"`
+	Latitude                *float64   `json:"latitude,omitempty"`
+	Longitude               *float64   `json:"longitude,omitempty"`
+	OpeningHours            *string    `json:"openingHours,omitempty"`
+	Phone                   *string    `json:"phone,omitempty"`
+	Status                  string     `json:"status,omitempty"`
+	Archived                bool       `json:"archived,omitempty"`
+	ArchivedAt              *time.Time `json:"archivedAt,omitempty"`
+	MaxProductsPerReception *int       `json:"maxProductsPerReception,omitempty"`
+	MaxOpenMinutes          *int       `json:"maxOpenMinutes,omitempty"`
+	MaxStorageVolumeCm3     *int64     `json:"maxStorageVolumeCm3,omitempty"`
+	StorageLimitMode        string     `json:"storageLimitMode,omitempty"`
+	Version                 int64      `json:"version,omitempty"`
+}
+
+type City struct {
+	Name      string    `json:"name"`
+	Region    string    `json:"region"`
+	Timezone  string    `json:"timezone"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"createdAt"`
 }
 
 type Reception struct {
-	Id       uuid.UUID `json:"id"`
-	PvzId    uuid.UUID `json:"pvzId"`
-	Status   string    `json:"status"`
-	DateTime time.Time `json:"dateTime"`
+	Id               uuid.UUID  `json:"id"`
+	PvzId            uuid.UUID  `json:"pvzId"`
+	Status           string     `json:"status"`
+	DateTime         time.Time  `json:"dateTime"`
+	DateTimeLocal    *time.Time `json:"dateTimeLocal,omitempty"`
+	ClosedAt         *time.Time `json:"closedAt,omitempty"`
+	ClosedAtLocal    *time.Time `json:"closedAtLocal,omitempty"`
+	DurationSeconds  *float64   `json:"durationSeconds,omitempty"`
+	ProductCount     *int       `json:"productCount,omitempty"`
+	TotalWeightGrams *int64     `json:"totalWeightGrams,omitempty"`
+	TotalVolumeCm3   *int64     `json:"totalVolumeCm3,omitempty"`
+	CancelReason     *string    `json:"cancelReason,omitempty"`
+	CancelledAt      *time.Time `json:"cancelledAt,omitempty"`
+	CancelledAtLocal *time.Time `json:"cancelledAtLocal,omitempty"`
+	AutoClosed       bool       `json:"autoClosed,omitempty"`
+	Timezone         string     `json:"timezone,omitempty"`
+	Version          int64      `json:"version,omitempty"`
 }
 
 type Product struct {
-	Id          uuid.UUID `json:"id"`
-	ReceptionId uuid.UUID `json:"receptionId"`
-	Type        string    `json:"type"`
-	DateTime    time.Time `json:"dateTime"`
+	Id                   uuid.UUID          `json:"id"`
+	ReceptionId          uuid.UUID          `json:"receptionId"`
+	Type                 string             `json:"type"`
+	Barcode              *string            `json:"barcode,omitempty"`
+	WeightGrams          *int               `json:"weightGrams,omitempty"`
+	Dimensions           *ProductDimensions `json:"dimensions,omitempty"`
+	Status               string             `json:"status,omitempty"`
+	DateTime             time.Time          `json:"dateTime"`
+	DateTimeLocal        *time.Time         `json:"dateTimeLocal,omitempty"`
+	StoredAt             *time.Time         `json:"storedAt,omitempty"`
+	StoredAtLocal        *time.Time         `json:"storedAtLocal,omitempty"`
+	IssuedAt             *time.Time         `json:"issuedAt,omitempty"`
+	IssuedAtLocal        *time.Time         `json:"issuedAtLocal,omitempty"`
+	ReturnedAt           *time.Time         `json:"returnedAt,omitempty"`
+	ReturnedAtLocal      *time.Time         `json:"returnedAtLocal,omitempty"`
+	ShipmentId           *uuid.UUID         `json:"shipmentId,omitempty"`
+	StorageLimitExceeded bool               `json:"storageLimitExceeded,omitempty"`
+}
+
+type Shipment struct {
+	Id            uuid.UUID  `json:"id"`
+	PvzId         uuid.UUID  `json:"pvzId"`
+	Status        string     `json:"status"`
+	DateTime      time.Time  `json:"dateTime"`
+	DateTimeLocal *time.Time `json:"dateTimeLocal,omitempty"`
+	ClosedAt      *time.Time `json:"closedAt,omitempty"`
+	ClosedAtLocal *time.Time `json:"closedAtLocal,omitempty"`
+	ProductCount  *int       `json:"productCount,omitempty"`
+	Timezone      string     `json:"timezone,omitempty"`
+}
+
+type ShipmentWithProducts struct {
+	Shipment Shipment  `json:"shipment"`
+	Products []Product `json:"products"`
+}
+
+type ProductDimensions struct {
+	LengthCm int `json:"lengthCm"`
+	WidthCm  int `json:"widthCm"`
+	HeightCm int `json:"heightCm"`
+}
+
+type ProductType struct {
+	Name       string    `json:"name"`
+	Deprecated bool      `json:"deprecated"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+type ProductBatchResult struct {
+	Index   int      `json:"index"`
+	Product *Product `json:"product,omitempty"`
+	Error   string   `json:"error,omitempty"`
+}
+
+type ProductBatch struct {
+	Created int                  `json:"created"`
+	Failed  int                  `json:"failed"`
+	Results []ProductBatchResult `json:"results"`
 }
 
 type ReceptionsWithProducts struct {
-	Products  []Product `json:"products"`
-	Reception Reception `json:"reception"`
+	Products         []Product `json:"products,omitzero"`
+	ProductCount     int       `json:"productCount"`
+	TotalWeightGrams int64     `json:"totalWeightGrams"`
+	TotalVolumeCm3   int64     `json:"totalVolumeCm3"`
+	Reception        Reception `json:"reception"`
 }
 
 type PvzInfo struct {
-	Pvz        Pvz                      `json:"pvz"`
-	Receptions []ReceptionsWithProducts `json:"receptions"`
+	Pvz            Pvz                      `json:"pvz"`
+	ReceptionCount *int                     `json:"receptionCount,omitempty"`
+	ProductCount   *int                     `json:"productCount,omitempty"`
+	Receptions     []ReceptionsWithProducts `json:"receptions,omitzero"`
+}
+
+// NearbyPvz ПВЗ с расстоянием до точки поиска в метрах.
+type NearbyPvz struct {
+	Pvz      Pvz     `json:"pvz"`
+	Distance float64 `json:"distance"`
+}
+
+type ProductsByType struct {
+	Type  string `json:"type"`
+	Count int    `json:"count"`
+}
+
+type ProductsByDay struct {
+	Date  string `json:"date"`
+	Count int    `json:"count"`
+}
+
+type Stats struct {
+	ReceptionCount                  int              `json:"receptionCount"`
+	AverageReceptionDurationSeconds float64          `json:"averageReceptionDurationSeconds"`
+	ProductsByType                  []ProductsByType `json:"productsByType"`
+	ProductsByDay                   []ProductsByDay  `json:"productsByDay"`
+}
+
+type ExportRow struct {
+	ReceptionId       uuid.UUID
+	PvzId             uuid.UUID
+	City              string
+	Timezone          string
+	ReceptionDateTime time.Time
+	ReceptionStatus   string
+	ProductId         *uuid.UUID
+	ProductType       *string
+	ProductDateTime   *time.Time
+}
+
+type DailyReport struct {
+	Date           string    `json:"date"`
+	PvzId          uuid.UUID `json:"pvzId"`
+	City           string    `json:"city"`
+	ReceptionCount int       `json:"receptionCount"`
+	ProductCount   int       `json:"productCount"`
 }
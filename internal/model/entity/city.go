@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"sync"
+	"time"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
+)
+
+// City город из справочника. В неактивном городе нельзя открыть новый ПВЗ.
+type City struct {
+	Name      string
+	Region    string
+	Timezone  string
+	Active    bool
+	CreatedAt time.Time
+}
+
+func (c *City) ToResponse() response.City {
+	return response.City{
+		Name:      c.Name,
+		Region:    c.Region,
+		Timezone:  c.Timezone,
+		Active:    c.Active,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+var locations sync.Map
+
+// LocalTime переводит t в часовой пояс города ПВЗ. Для пустого или неизвестного пояса возвращает nil.
+func LocalTime(t time.Time, timezone string) *time.Time {
+	if timezone == "" {
+		return nil
+	}
+
+	loc, ok := locations.Load(timezone)
+	if !ok {
+		l, err := time.LoadLocation(timezone)
+		if err != nil {
+			return nil
+		}
+
+		loc, _ = locations.LoadOrStore(timezone, l)
+	}
+
+	local := t.In(loc.(*time.Location))
+
+	return &local
+}
+
+func localTimePtr(t *time.Time, timezone string) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	return LocalTime(*t, timezone)
+}
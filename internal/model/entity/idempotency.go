@@ -0,0 +1,24 @@
+package entity
+
+import "time"
+
+// IdempotencyKey сохраненный ответ на запрос с заголовком Idempotency-Key.
+// Пока запрос выполняется, StatusCode пустой. Subject - хеш токена вызывающего:
+// ответ повторяется только тому, кто отправил исходный запрос.
+type IdempotencyKey struct {
+	Key          string
+	Method       string
+	Path         string
+	Subject      string
+	RequestHash  string
+	StatusCode   *int
+	ContentType  string
+	Headers      map[string]string
+	ResponseBody []byte
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+}
+
+func (k *IdempotencyKey) Completed() bool {
+	return k.StatusCode != nil
+}
@@ -1,24 +1,174 @@
 package entity
 
 import (
+	"regexp"
 	"time"
 
 	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
 	"github.com/google/uuid"
 )
 
+// Базовые типы товаров, заведенные миграцией. Актуальный список хранится в таблице product_type.
+const (
+	ProductTypeElectronics = "электроника"
+	ProductTypeClothes     = "одежда"
+	ProductTypeShoes       = "обувь"
+)
+
+const (
+	ProductBatchModeAtomic  = "atomic"
+	ProductBatchModePartial = "partial"
+)
+
+// Жизненный цикл товара: accepted → stored → issued | returned_to_sender.
+const (
+	ProductStatusAccepted         = "accepted"
+	ProductStatusStored           = "stored"
+	ProductStatusIssued           = "issued"
+	ProductStatusReturnedToSender = "returned_to_sender"
+)
+
+var productTransitions = map[string][]string{
+	ProductStatusAccepted: {ProductStatusStored},
+	ProductStatusStored:   {ProductStatusIssued, ProductStatusReturnedToSender},
+}
+
+// CanChangeProductStatus проверяет, допустим ли переход товара из статуса from в статус to.
+func CanChangeProductStatus(from, to string) bool {
+	for _, status := range productTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsOnHand сообщает, находится ли товар в статусе status физически в ПВЗ.
+func IsOnHand(status string) bool {
+	return status == ProductStatusAccepted || status == ProductStatusStored
+}
+
+var (
+	ean13Pattern      = regexp.MustCompile(`^\d{13}$`)
+	parcelCodePattern = regexp.MustCompile(`^PVZ\d{10}$`)
+)
+
+// IsValidBarcode принимает EAN-13 с корректной контрольной цифрой
+// или внутренний код посылки вида PVZ0123456789.
+func IsValidBarcode(barcode string) bool {
+	if parcelCodePattern.MatchString(barcode) {
+		return true
+	}
+
+	if !ean13Pattern.MatchString(barcode) {
+		return false
+	}
+
+	sum := 0
+	for i, r := range barcode[:12] {
+		digit := int(r - '0')
+		if i%2 == 1 {
+			digit *= 3
+		}
+		sum += digit
+	}
+
+	return (10-sum%10)%10 == int(barcode[12]-'0')
+}
+
 type Product struct {
 	Id          uuid.UUID
 	ReceptionId uuid.UUID
 	Type        string
+	Barcode     *string
+	WeightGrams *int
+	Dimensions  *ProductDimensions
+	Status      string
 	DateTime    time.Time
+	StoredAt    *time.Time
+	IssuedAt    *time.Time
+	ReturnedAt  *time.Time
+	ShipmentId  *uuid.UUID
+	Timezone    string
+	// StorageLimitExceeded товар принят сверх лимита объема ПВЗ в режиме warn.
+	StorageLimitExceeded bool
+}
+
+// ProductDimensions габариты товара в сантиметрах.
+type ProductDimensions struct {
+	LengthCm int
+	WidthCm  int
+	HeightCm int
+}
+
+// HasValidSize проверяет, что заданные вес и габариты положительны.
+func (p *Product) HasValidSize() bool {
+	if p.WeightGrams != nil && *p.WeightGrams <= 0 {
+		return false
+	}
+
+	d := p.Dimensions
+
+	return d == nil || d.LengthCm > 0 && d.WidthCm > 0 && d.HeightCm > 0
+}
+
+// VolumeCm3 возвращает объем товара. Товар без габаритов в расчете объема не участвует.
+func (p *Product) VolumeCm3() int64 {
+	if p.Dimensions == nil {
+		return 0
+	}
+
+	return int64(p.Dimensions.LengthCm) * int64(p.Dimensions.WidthCm) * int64(p.Dimensions.HeightCm)
 }
 
 func (p *Product) ToResponse() *response.Product {
-	return &response.Product{
-		Id:          p.Id,
-		ReceptionId: p.ReceptionId,
-		Type:        p.Type,
-		DateTime:    p.DateTime,
+	resp := &response.Product{
+		Id:                   p.Id,
+		ReceptionId:          p.ReceptionId,
+		Type:                 p.Type,
+		Barcode:              p.Barcode,
+		WeightGrams:          p.WeightGrams,
+		Status:               p.Status,
+		DateTime:             p.DateTime,
+		DateTimeLocal:        LocalTime(p.DateTime, p.Timezone),
+		StoredAt:             p.StoredAt,
+		StoredAtLocal:        localTimePtr(p.StoredAt, p.Timezone),
+		IssuedAt:             p.IssuedAt,
+		IssuedAtLocal:        localTimePtr(p.IssuedAt, p.Timezone),
+		ReturnedAt:           p.ReturnedAt,
+		ReturnedAtLocal:      localTimePtr(p.ReturnedAt, p.Timezone),
+		ShipmentId:           p.ShipmentId,
+		StorageLimitExceeded: p.StorageLimitExceeded,
 	}
+
+	if p.Dimensions != nil {
+		resp.Dimensions = &response.ProductDimensions{
+			LengthCm: p.Dimensions.LengthCm,
+			WidthCm:  p.Dimensions.WidthCm,
+			HeightCm: p.Dimensions.HeightCm,
+		}
+	}
+
+	return resp
+}
+
+// ProductBatchResult результат добавления одного товара из пакета.
+type ProductBatchResult struct {
+	Product *Product
+	Err     error
+}
+
+func (r *ProductBatchResult) ToResponse(index int) response.ProductBatchResult {
+	result := response.ProductBatchResult{
+		Index: index,
+	}
+
+	if r.Err != nil {
+		result.Error = r.Err.Error()
+	} else if r.Product != nil {
+		result.Product = r.Product.ToResponse()
+	}
+
+	return result
 }
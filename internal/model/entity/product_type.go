@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
+)
+
+// ProductType тип товара из справочника. Устаревший тип нельзя использовать
+// для новых товаров, но он остается у уже принятых.
+type ProductType struct {
+	Name       string
+	Deprecated bool
+	CreatedAt  time.Time
+}
+
+func (t *ProductType) ToResponse() response.ProductType {
+	return response.ProductType{
+		Name:       t.Name,
+		Deprecated: t.Deprecated,
+		CreatedAt:  t.CreatedAt,
+	}
+}
@@ -7,16 +7,124 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	PvzSortRegistrationDate = "registrationDate"
+	PvzSortCity             = "city"
+	PvzSortLastReception    = "lastReception"
+	PvzSortProductCount     = "productCount"
+
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+
+	PvzViewSummary        = "summary"
+	PvzViewWithReceptions = "with_receptions"
+	PvzViewFull           = "full"
+
+	PvzStatusActive    = "active"
+	PvzStatusSuspended = "suspended"
+	PvzStatusClosed    = "closed"
+
+	// Радиус поиска ближайших ПВЗ в метрах.
+	PvzNearbyDefaultRadius = 5000
+	PvzNearbyMaxRadius     = 50000
+
+	PvzNearbyDefaultLimit = 20
+	PvzNearbyMaxLimit     = 100
+)
+
+// Режимы лимита объема хранения ПВЗ.
+const (
+	StorageLimitModeWarn   = "warn"
+	StorageLimitModeReject = "reject"
+)
+
+var (
+	pvzViews = map[string]struct{}{
+		PvzViewSummary:        {},
+		PvzViewWithReceptions: {},
+		PvzViewFull:           {},
+	}
+)
+
+func IsValidPvzView(view string) bool {
+	_, ok := pvzViews[view]
+
+	return ok
+}
+
+func IsValidCoordinates(latitude, longitude float64) bool {
+	return latitude >= -90 && latitude <= 90 && longitude >= -180 && longitude <= 180
+}
+
 type Pvz struct {
 	Id               uuid.UUID
 	City             string
 	RegistrationDate time.Time
+	Address          *string
+	Latitude         *float64
+	Longitude        *float64
+	OpeningHours     *string
+	Phone            *string
+	Status           string
+	Archived         bool
+	ArchivedAt       *time.Time
+	// Timezone часовой пояс города ПВЗ, в нем отдается локальное время.
+	Timezone string
+	Settings PvzSettings
+	// Version увеличивается при каждом изменении ПВЗ и отдается клиенту как ETag.
+	Version int64
+}
+
+// PvzUpdate изменяемые модератором поля ПВЗ. nil означает, что поле не меняется.
+type PvzUpdate struct {
+	Address      *string
+	Latitude     *float64
+	Longitude    *float64
+	OpeningHours *string
+	Phone        *string
+	Status       *string
+}
+
+// PvzSettings ограничения для приемок ПВЗ. nil означает отсутствие ограничения.
+// StorageLimitMode определяет, отклонять товары сверх MaxStorageVolumeCm3 или только помечать их.
+type PvzSettings struct {
+	MaxProductsPerReception *int
+	MaxOpenMinutes          *int
+	MaxStorageVolumeCm3     *int64
+	StorageLimitMode        string
+}
+
+// StorageUsage занятый товарами объем ПВЗ и его лимит.
+type StorageUsage struct {
+	UsedVolumeCm3 int64
+	MaxVolumeCm3  *int64
+	LimitMode     string
+}
+
+// Exceeds сообщает, превысит ли добавление volume лимит объема ПВЗ.
+func (u *StorageUsage) Exceeds(volume int64) bool {
+	return volume > 0 && u.MaxVolumeCm3 != nil && u.UsedVolumeCm3+volume > *u.MaxVolumeCm3
 }
 
 func (p *Pvz) ToResponse() response.Pvz {
 	return response.Pvz{
-		Id:               p.Id,
-		City:             p.City,
-		RegistrationDate: p.RegistrationDate,
+		Id:                      p.Id,
+		City:                    p.City,
+		RegistrationDate:        p.RegistrationDate,
+		RegistrationDateLocal:   LocalTime(p.RegistrationDate, p.Timezone),
+		Timezone:                p.Timezone,
+		Address:                 p.Address,
+		Latitude:                p.Latitude,
+		Longitude:               p.Longitude,
+		OpeningHours:            p.OpeningHours,
+		Phone:                   p.Phone,
+		Status:                  p.Status,
+		Archived:                p.Archived,
+		ArchivedAt:              p.ArchivedAt,
+		MaxProductsPerReception: p.Settings.MaxProductsPerReception,
+		MaxOpenMinutes:          p.Settings.MaxOpenMinutes,
+		MaxStorageVolumeCm3:     p.Settings.MaxStorageVolumeCm3,
+		StorageLimitMode:        p.Settings.StorageLimitMode,
+		Version:                 p.Version,
 	}
 }
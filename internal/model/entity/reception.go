@@ -7,18 +7,65 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	ReceptionStatusInProgress = "in_progress"
+	ReceptionStatusClosed     = "closed"
+	ReceptionStatusCancelled  = "cancelled"
+)
+
 type Reception struct {
-	Id       uuid.UUID
-	PvzId    uuid.UUID
-	Status   string
-	DateTime time.Time
+	Id           uuid.UUID
+	PvzId        uuid.UUID
+	Status       string
+	DateTime     time.Time
+	ClosedAt     *time.Time
+	ProductCount *int
+	// Суммарные вес и объем товаров приемки без учета товаров, у которых они не указаны.
+	TotalWeightGrams *int64
+	TotalVolumeCm3   *int64
+	CancelReason     *string
+	CancelledAt      *time.Time
+	AutoClosed       bool
+	Timezone         string
+	// Version увеличивается при каждом изменении приемки и отдается клиенту как ETag.
+	Version int64
+}
+
+// Duration возвращает длительность приемки, если она закрыта.
+func (r *Reception) Duration() *time.Duration {
+	if r.ClosedAt == nil {
+		return nil
+	}
+
+	d := r.ClosedAt.Sub(r.DateTime)
+
+	return &d
 }
 
 func (r *Reception) ToResponse() response.Reception {
-	return response.Reception{
-		Id:       r.Id,
-		PvzId:    r.PvzId,
-		Status:   r.Status,
-		DateTime: r.DateTime,
+	resp := response.Reception{
+		Id:               r.Id,
+		PvzId:            r.PvzId,
+		Status:           r.Status,
+		DateTime:         r.DateTime,
+		DateTimeLocal:    LocalTime(r.DateTime, r.Timezone),
+		ClosedAt:         r.ClosedAt,
+		ClosedAtLocal:    localTimePtr(r.ClosedAt, r.Timezone),
+		ProductCount:     r.ProductCount,
+		TotalWeightGrams: r.TotalWeightGrams,
+		TotalVolumeCm3:   r.TotalVolumeCm3,
+		CancelReason:     r.CancelReason,
+		CancelledAt:      r.CancelledAt,
+		CancelledAtLocal: localTimePtr(r.CancelledAt, r.Timezone),
+		AutoClosed:       r.AutoClosed,
+		Timezone:         r.Timezone,
+		Version:          r.Version,
 	}
+
+	if d := r.Duration(); d != nil {
+		seconds := d.Seconds()
+		resp.DurationSeconds = &seconds
+	}
+
+	return resp
 }
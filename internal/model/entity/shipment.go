@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
+	"github.com/google/uuid"
+)
+
+const (
+	ShipmentStatusInProgress = "in_progress"
+	ShipmentStatusClosed     = "closed"
+)
+
+// Shipment отгрузка невостребованных товаров отправителю. При закрытии отгрузки
+// прикрепленные к ней товары переходят в статус returned_to_sender.
+type Shipment struct {
+	Id           uuid.UUID
+	PvzId        uuid.UUID
+	Status       string
+	DateTime     time.Time
+	ClosedAt     *time.Time
+	ProductCount *int
+	Timezone     string
+}
+
+func (s *Shipment) ToResponse() response.Shipment {
+	return response.Shipment{
+		Id:            s.Id,
+		PvzId:         s.PvzId,
+		Status:        s.Status,
+		DateTime:      s.DateTime,
+		DateTimeLocal: LocalTime(s.DateTime, s.Timezone),
+		ClosedAt:      s.ClosedAt,
+		ClosedAtLocal: localTimePtr(s.ClosedAt, s.Timezone),
+		ProductCount:  s.ProductCount,
+		Timezone:      s.Timezone,
+	}
+}
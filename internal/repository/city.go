@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/database"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+)
+
+var (
+	ErrCityAlreadyExists = apperror.Conflict("city_already_exists", "city already exists")
+	ErrCityNotFound      = apperror.NotFound("city_not_found", "city not found")
+	ErrCityInUse         = apperror.Conflict("city_in_use", "city has pvz, deactivate it instead")
+)
+
+const cityColumns = `name, region, timezone, active, created_at`
+
+type CityRepository struct {
+	db *sql.DB
+}
+
+func NewCityRepository(db *sql.DB) *CityRepository {
+	return &CityRepository{
+		db: db,
+	}
+}
+
+func (r *CityRepository) CreateCity(city *entity.City) (*entity.City, error) {
+	log.SetPrefix("repository.CreateCity")
+	query := `INSERT INTO city (` + cityColumns + `) VALUES ($1, $2, $3, $4, $5)`
+
+	city.CreatedAt = time.Now().UTC()
+
+	if _, err := r.db.Exec(query, city.Name, city.Region, city.Timezone, city.Active, city.CreatedAt); err != nil {
+		if database.IsUniqueViolation(err) {
+			return nil, ErrCityAlreadyExists
+		}
+
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	return city, nil
+}
+
+func (r *CityRepository) GetCities(includeInactive bool) ([]*entity.City, error) {
+	log.SetPrefix("repository.GetCities")
+	query := `SELECT ` + cityColumns + ` FROM city WHERE $1 OR active ORDER BY name`
+
+	rows, err := r.db.Query(query, includeInactive)
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+	defer rows.Close()
+
+	cities := make([]*entity.City, 0)
+	for rows.Next() {
+		var city entity.City
+		if err = rows.Scan(&city.Name, &city.Region, &city.Timezone, &city.Active, &city.CreatedAt); err != nil {
+			log.Printf("error: %v", err)
+
+			return nil, err
+		}
+
+		cities = append(cities, &city)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	return cities, nil
+}
+
+// UpdateCity обновляет город. Переименование каскадно применяется к pvz.city.
+func (r *CityRepository) UpdateCity(name string, city *entity.City) (*entity.City, error) {
+	log.SetPrefix("repository.UpdateCity")
+	query := `UPDATE city SET name = $2, region = $3, timezone = $4, active = $5 WHERE name = $1 RETURNING ` + cityColumns
+
+	var updated entity.City
+	err := r.db.QueryRow(query, name, city.Name, city.Region, city.Timezone, city.Active).
+		Scan(&updated.Name, &updated.Region, &updated.Timezone, &updated.Active, &updated.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCityNotFound
+		}
+
+		if database.IsUniqueViolation(err) {
+			return nil, ErrCityAlreadyExists
+		}
+
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+func (r *CityRepository) DeleteCity(name string) error {
+	log.SetPrefix("repository.DeleteCity")
+	query := `DELETE FROM city WHERE name = $1`
+
+	res, err := r.db.Exec(query, name)
+	if err != nil {
+		if database.IsForeignKeyViolation(err) {
+			return ErrCityInUse
+		}
+
+		log.Printf("error: %v", err)
+
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return err
+	}
+
+	if affected == 0 {
+		return ErrCityNotFound
+	}
+
+	return nil
+}
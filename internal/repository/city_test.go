@@ -0,0 +1,108 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/alexey-shedrin/avito-test-task/internal/repository"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCity(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewCityRepository(db)
+	city := &entity.City{Name: "Екатеринбург", Region: "Свердловская область", Timezone: "Asia/Yekaterinburg", Active: true}
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec("INSERT INTO city \\(name, region, timezone, active, created_at\\)").
+			WithArgs(city.Name, city.Region, city.Timezone, true, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		result, err := repo.CreateCity(city)
+
+		require.NoError(t, err)
+		require.NotZero(t, result.CreatedAt)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("AlreadyExists", func(t *testing.T) {
+		mock.ExpectExec("INSERT INTO city").
+			WillReturnError(&pq.Error{Code: "23505"})
+
+		result, err := repo.CreateCity(city)
+
+		require.Equal(t, repository.ErrCityAlreadyExists, err)
+		require.Nil(t, result)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestUpdateCity_Rename(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewCityRepository(db)
+	city := &entity.City{Name: "Санкт-Петербург", Region: "Ленинградская область", Timezone: "Europe/Moscow", Active: true}
+
+	mock.ExpectQuery("UPDATE city SET name = \\$2, region = \\$3, timezone = \\$4, active = \\$5 WHERE name = \\$1").
+		WithArgs("Ленинград", city.Name, city.Region, city.Timezone, true).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "region", "timezone", "active", "created_at"}).
+			AddRow(city.Name, city.Region, city.Timezone, true, time.Now()))
+
+	result, err := repo.UpdateCity("Ленинград", city)
+
+	require.NoError(t, err)
+	require.Equal(t, city.Name, result.Name)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateCity_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewCityRepository(db)
+
+	mock.ExpectQuery("UPDATE city").
+		WillReturnError(sql.ErrNoRows)
+
+	result, err := repo.UpdateCity("Атлантида", &entity.City{Name: "Атлантида"})
+
+	require.Equal(t, repository.ErrCityNotFound, err)
+	require.Nil(t, result)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteCity(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewCityRepository(db)
+
+	t.Run("InUse", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM city WHERE name = \\$1").
+			WithArgs("Москва").
+			WillReturnError(&pq.Error{Code: "23503"})
+
+		require.Equal(t, repository.ErrCityInUse, repo.DeleteCity("Москва"))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectExec("DELETE FROM city WHERE name = \\$1").
+			WithArgs("Атлантида").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		require.Equal(t, repository.ErrCityNotFound, repo.DeleteCity("Атлантида"))
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+)
+
+type IdempotencyRepository struct {
+	db *sql.DB
+}
+
+func NewIdempotencyRepository(db *sql.DB) *IdempotencyRepository {
+	return &IdempotencyRepository{
+		db: db,
+	}
+}
+
+// AcquireIdempotencyKey резервирует ключ под выполняемый запрос. Истекший ключ
+// перезаписывается. Возвращает false, если ключ уже занят.
+func (r *IdempotencyRepository) AcquireIdempotencyKey(key *entity.IdempotencyKey) (bool, error) {
+	log.SetPrefix("repository.AcquireIdempotencyKey")
+	query := `INSERT INTO idempotency_key (key, method, path, subject, request_hash, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (key, method, path, subject) DO UPDATE SET
+			request_hash = EXCLUDED.request_hash,
+			status_code = NULL,
+			content_type = NULL,
+			response_headers = NULL,
+			response_body = NULL,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_key.expires_at <= EXCLUDED.created_at`
+
+	res, err := r.db.Exec(query, key.Key, key.Method, key.Path, key.Subject, key.RequestHash, key.CreatedAt, key.ExpiresAt)
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// GetIdempotencyKey возвращает nil, если ключа нет.
+func (r *IdempotencyRepository) GetIdempotencyKey(key *entity.IdempotencyKey) (*entity.IdempotencyKey, error) {
+	log.SetPrefix("repository.GetIdempotencyKey")
+	query := `SELECT key, method, path, subject, request_hash, status_code, COALESCE(content_type, ''), response_headers, response_body, created_at, expires_at
+		FROM idempotency_key WHERE key = $1 AND method = $2 AND path = $3 AND subject = $4`
+
+	var (
+		k          entity.IdempotencyKey
+		statusCode sql.NullInt64
+		headers    []byte
+	)
+
+	err := r.db.QueryRow(query, key.Key, key.Method, key.Path, key.Subject).Scan(
+		&k.Key, &k.Method, &k.Path, &k.Subject, &k.RequestHash, &statusCode, &k.ContentType, &headers, &k.ResponseBody, &k.CreatedAt, &k.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	if statusCode.Valid {
+		code := int(statusCode.Int64)
+		k.StatusCode = &code
+	}
+
+	if headers != nil {
+		if err = json.Unmarshal(headers, &k.Headers); err != nil {
+			log.Printf("error: %v", err)
+
+			return nil, err
+		}
+	}
+
+	return &k, nil
+}
+
+func (r *IdempotencyRepository) SaveIdempotencyResponse(key *entity.IdempotencyKey) error {
+	log.SetPrefix("repository.SaveIdempotencyResponse")
+	query := `UPDATE idempotency_key SET status_code = $5, content_type = $6, response_headers = $7, response_body = $8
+		WHERE key = $1 AND method = $2 AND path = $3 AND subject = $4`
+
+	headers, err := json.Marshal(key.Headers)
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return err
+	}
+
+	if _, err = r.db.Exec(query, key.Key, key.Method, key.Path, key.Subject, key.StatusCode, key.ContentType, headers, key.ResponseBody); err != nil {
+		log.Printf("error: %v", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// ReleaseIdempotencyKey освобождает ключ, чтобы повторный запрос выполнился заново.
+func (r *IdempotencyRepository) ReleaseIdempotencyKey(key *entity.IdempotencyKey) error {
+	log.SetPrefix("repository.ReleaseIdempotencyKey")
+	query := `DELETE FROM idempotency_key WHERE key = $1 AND method = $2 AND path = $3 AND subject = $4`
+
+	if _, err := r.db.Exec(query, key.Key, key.Method, key.Path, key.Subject); err != nil {
+		log.Printf("error: %v", err)
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *IdempotencyRepository) DeleteExpiredIdempotencyKeys(now time.Time) (int64, error) {
+	log.SetPrefix("repository.DeleteExpiredIdempotencyKeys")
+	query := `DELETE FROM idempotency_key WHERE expires_at <= $1`
+
+	res, err := r.db.Exec(query, now)
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
@@ -0,0 +1,105 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/alexey-shedrin/avito-test-task/internal/repository"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcquireIdempotencyKey(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewIdempotencyRepository(db)
+	now := time.Now().UTC()
+	key := &entity.IdempotencyKey{
+		Key:         "retry-1",
+		Method:      "POST",
+		Path:        "/products",
+		Subject:     "subject",
+		RequestHash: "hash",
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(24 * time.Hour),
+	}
+
+	t.Run("Acquired", func(t *testing.T) {
+		mock.ExpectExec("INSERT INTO idempotency_key .+ ON CONFLICT \\(key, method, path, subject\\) DO UPDATE .+ WHERE idempotency_key.expires_at <= EXCLUDED.created_at").
+			WithArgs(key.Key, key.Method, key.Path, key.Subject, key.RequestHash, key.CreatedAt, key.ExpiresAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		acquired, err := repo.AcquireIdempotencyKey(key)
+
+		require.NoError(t, err)
+		require.True(t, acquired)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Taken", func(t *testing.T) {
+		mock.ExpectExec("INSERT INTO idempotency_key").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		acquired, err := repo.AcquireIdempotencyKey(key)
+
+		require.NoError(t, err)
+		require.False(t, acquired)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestGetIdempotencyKey(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewIdempotencyRepository(db)
+	columns := []string{
+		"key", "method", "path", "subject", "request_hash", "status_code", "content_type", "response_headers", "response_body", "created_at", "expires_at",
+	}
+	now := time.Now().UTC()
+	key := &entity.IdempotencyKey{Key: "retry-1", Method: "POST", Path: "/products", Subject: "subject"}
+
+	t.Run("Completed", func(t *testing.T) {
+		mock.ExpectQuery("SELECT .+ FROM idempotency_key WHERE key = \\$1 AND method = \\$2 AND path = \\$3 AND subject = \\$4").
+			WithArgs("retry-1", "POST", "/products", "subject").
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow("retry-1", "POST", "/products", "subject", "hash", 201, "application/json", []byte(`{"ETag":"\"1\""}`), []byte(`{}`), now, now))
+
+		stored, err := repo.GetIdempotencyKey(key)
+
+		require.NoError(t, err)
+		require.True(t, stored.Completed())
+		require.Equal(t, 201, *stored.StatusCode)
+		require.Equal(t, []byte(`{}`), stored.ResponseBody)
+		require.Equal(t, map[string]string{"ETag": `"1"`}, stored.Headers)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("InProgress", func(t *testing.T) {
+		mock.ExpectQuery("SELECT .+ FROM idempotency_key").
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow("retry-1", "POST", "/products", "subject", "hash", nil, "", nil, nil, now, now))
+
+		stored, err := repo.GetIdempotencyKey(key)
+
+		require.NoError(t, err)
+		require.False(t, stored.Completed())
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectQuery("SELECT .+ FROM idempotency_key").
+			WillReturnError(sql.ErrNoRows)
+
+		stored, err := repo.GetIdempotencyKey(key)
+
+		require.NoError(t, err)
+		require.Nil(t, stored)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
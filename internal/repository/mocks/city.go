@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/service/city.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/service/city.go -destination=internal/repository/mocks/city.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	entity "github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCityRepository is a mock of CityRepository interface.
+type MockCityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCityRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCityRepositoryMockRecorder is the mock recorder for MockCityRepository.
+type MockCityRepositoryMockRecorder struct {
+	mock *MockCityRepository
+}
+
+// NewMockCityRepository creates a new mock instance.
+func NewMockCityRepository(ctrl *gomock.Controller) *MockCityRepository {
+	mock := &MockCityRepository{ctrl: ctrl}
+	mock.recorder = &MockCityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCityRepository) EXPECT() *MockCityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateCity mocks base method.
+func (m *MockCityRepository) CreateCity(city *entity.City) (*entity.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCity", city)
+	ret0, _ := ret[0].(*entity.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCity indicates an expected call of CreateCity.
+func (mr *MockCityRepositoryMockRecorder) CreateCity(city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCity", reflect.TypeOf((*MockCityRepository)(nil).CreateCity), city)
+}
+
+// DeleteCity mocks base method.
+func (m *MockCityRepository) DeleteCity(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCity", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCity indicates an expected call of DeleteCity.
+func (mr *MockCityRepositoryMockRecorder) DeleteCity(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCity", reflect.TypeOf((*MockCityRepository)(nil).DeleteCity), name)
+}
+
+// GetCities mocks base method.
+func (m *MockCityRepository) GetCities(includeInactive bool) ([]*entity.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCities", includeInactive)
+	ret0, _ := ret[0].([]*entity.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCities indicates an expected call of GetCities.
+func (mr *MockCityRepositoryMockRecorder) GetCities(includeInactive any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCities", reflect.TypeOf((*MockCityRepository)(nil).GetCities), includeInactive)
+}
+
+// UpdateCity mocks base method.
+func (m *MockCityRepository) UpdateCity(name string, city *entity.City) (*entity.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCity", name, city)
+	ret0, _ := ret[0].(*entity.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCity indicates an expected call of UpdateCity.
+func (mr *MockCityRepositoryMockRecorder) UpdateCity(name, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCity", reflect.TypeOf((*MockCityRepository)(nil).UpdateCity), name, city)
+}
@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/middleware/idempotency.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/middleware/idempotency.go -destination=internal/repository/mocks/idempotency.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	entity "github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIdempotencyStore is a mock of IdempotencyStore interface.
+type MockIdempotencyStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockIdempotencyStoreMockRecorder is the mock recorder for MockIdempotencyStore.
+type MockIdempotencyStoreMockRecorder struct {
+	mock *MockIdempotencyStore
+}
+
+// NewMockIdempotencyStore creates a new mock instance.
+func NewMockIdempotencyStore(ctrl *gomock.Controller) *MockIdempotencyStore {
+	mock := &MockIdempotencyStore{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyStore) EXPECT() *MockIdempotencyStoreMockRecorder {
+	return m.recorder
+}
+
+// AcquireIdempotencyKey mocks base method.
+func (m *MockIdempotencyStore) AcquireIdempotencyKey(key *entity.IdempotencyKey) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireIdempotencyKey", key)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireIdempotencyKey indicates an expected call of AcquireIdempotencyKey.
+func (mr *MockIdempotencyStoreMockRecorder) AcquireIdempotencyKey(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireIdempotencyKey", reflect.TypeOf((*MockIdempotencyStore)(nil).AcquireIdempotencyKey), key)
+}
+
+// GetIdempotencyKey mocks base method.
+func (m *MockIdempotencyStore) GetIdempotencyKey(key *entity.IdempotencyKey) (*entity.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdempotencyKey", key)
+	ret0, _ := ret[0].(*entity.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdempotencyKey indicates an expected call of GetIdempotencyKey.
+func (mr *MockIdempotencyStoreMockRecorder) GetIdempotencyKey(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdempotencyKey", reflect.TypeOf((*MockIdempotencyStore)(nil).GetIdempotencyKey), key)
+}
+
+// ReleaseIdempotencyKey mocks base method.
+func (m *MockIdempotencyStore) ReleaseIdempotencyKey(key *entity.IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseIdempotencyKey", key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseIdempotencyKey indicates an expected call of ReleaseIdempotencyKey.
+func (mr *MockIdempotencyStoreMockRecorder) ReleaseIdempotencyKey(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseIdempotencyKey", reflect.TypeOf((*MockIdempotencyStore)(nil).ReleaseIdempotencyKey), key)
+}
+
+// SaveIdempotencyResponse mocks base method.
+func (m *MockIdempotencyStore) SaveIdempotencyResponse(key *entity.IdempotencyKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveIdempotencyResponse", key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveIdempotencyResponse indicates an expected call of SaveIdempotencyResponse.
+func (mr *MockIdempotencyStoreMockRecorder) SaveIdempotencyResponse(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveIdempotencyResponse", reflect.TypeOf((*MockIdempotencyStore)(nil).SaveIdempotencyResponse), key)
+}
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/service/product_type.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/service/product_type.go -destination=internal/repository/mocks/product_type.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	entity "github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProductTypeRepository is a mock of ProductTypeRepository interface.
+type MockProductTypeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductTypeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockProductTypeRepositoryMockRecorder is the mock recorder for MockProductTypeRepository.
+type MockProductTypeRepositoryMockRecorder struct {
+	mock *MockProductTypeRepository
+}
+
+// NewMockProductTypeRepository creates a new mock instance.
+func NewMockProductTypeRepository(ctrl *gomock.Controller) *MockProductTypeRepository {
+	mock := &MockProductTypeRepository{ctrl: ctrl}
+	mock.recorder = &MockProductTypeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductTypeRepository) EXPECT() *MockProductTypeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateProductType mocks base method.
+func (m *MockProductTypeRepository) CreateProductType(productType *entity.ProductType) (*entity.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductType", productType)
+	ret0, _ := ret[0].(*entity.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProductType indicates an expected call of CreateProductType.
+func (mr *MockProductTypeRepositoryMockRecorder) CreateProductType(productType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductType", reflect.TypeOf((*MockProductTypeRepository)(nil).CreateProductType), productType)
+}
+
+// GetProductTypes mocks base method.
+func (m *MockProductTypeRepository) GetProductTypes(includeDeprecated bool) ([]*entity.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductTypes", includeDeprecated)
+	ret0, _ := ret[0].([]*entity.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductTypes indicates an expected call of GetProductTypes.
+func (mr *MockProductTypeRepositoryMockRecorder) GetProductTypes(includeDeprecated any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductTypes", reflect.TypeOf((*MockProductTypeRepository)(nil).GetProductTypes), includeDeprecated)
+}
+
+// SetProductTypeDeprecated mocks base method.
+func (m *MockProductTypeRepository) SetProductTypeDeprecated(name string, deprecated bool) (*entity.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProductTypeDeprecated", name, deprecated)
+	ret0, _ := ret[0].(*entity.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetProductTypeDeprecated indicates an expected call of SetProductTypeDeprecated.
+func (mr *MockProductTypeRepositoryMockRecorder) SetProductTypeDeprecated(name, deprecated any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProductTypeDeprecated", reflect.TypeOf((*MockProductTypeRepository)(nil).SetProductTypeDeprecated), name, deprecated)
+}
@@ -13,8 +13,10 @@ import (
 	reflect "reflect"
 	time "time"
 
+	request "github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
 	response "github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
 	entity "github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,6 +44,21 @@ func (m *MockPVZRepository) EXPECT() *MockPVZRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ArchivePvz mocks base method.
+func (m *MockPVZRepository) ArchivePvz(pvzID uuid.UUID, at time.Time, versions []int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchivePvz", pvzID, at, versions)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchivePvz indicates an expected call of ArchivePvz.
+func (mr *MockPVZRepositoryMockRecorder) ArchivePvz(pvzID, at, versions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchivePvz", reflect.TypeOf((*MockPVZRepository)(nil).ArchivePvz), pvzID, at, versions)
+}
+
 // CreatePvz mocks base method.
 func (m *MockPVZRepository) CreatePvz(pvz *entity.Pvz) (*entity.Pvz, error) {
 	m.ctrl.T.Helper()
@@ -57,17 +74,92 @@ func (mr *MockPVZRepositoryMockRecorder) CreatePvz(pvz any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePvz", reflect.TypeOf((*MockPVZRepository)(nil).CreatePvz), pvz)
 }
 
+// GetNearbyPvz mocks base method.
+func (m *MockPVZRepository) GetNearbyPvz(req *request.GetNearbyPvz) ([]response.NearbyPvz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNearbyPvz", req)
+	ret0, _ := ret[0].([]response.NearbyPvz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNearbyPvz indicates an expected call of GetNearbyPvz.
+func (mr *MockPVZRepositoryMockRecorder) GetNearbyPvz(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNearbyPvz", reflect.TypeOf((*MockPVZRepository)(nil).GetNearbyPvz), req)
+}
+
 // GetPvz mocks base method.
-func (m *MockPVZRepository) GetPvz(startDate, endDate *time.Time, page, limit *int) ([]response.PvzInfo, error) {
+func (m *MockPVZRepository) GetPvz(req *request.GetPvz) ([]response.PvzInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPvz", startDate, endDate, page, limit)
+	ret := m.ctrl.Call(m, "GetPvz", req)
 	ret0, _ := ret[0].([]response.PvzInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPvz indicates an expected call of GetPvz.
-func (mr *MockPVZRepositoryMockRecorder) GetPvz(startDate, endDate, page, limit any) *gomock.Call {
+func (mr *MockPVZRepositoryMockRecorder) GetPvz(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPvz", reflect.TypeOf((*MockPVZRepository)(nil).GetPvz), req)
+}
+
+// HasOpenedReception mocks base method.
+func (m *MockPVZRepository) HasOpenedReception(pvzID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasOpenedReception", pvzID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasOpenedReception indicates an expected call of HasOpenedReception.
+func (mr *MockPVZRepositoryMockRecorder) HasOpenedReception(pvzID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasOpenedReception", reflect.TypeOf((*MockPVZRepository)(nil).HasOpenedReception), pvzID)
+}
+
+// IsActiveCity mocks base method.
+func (m *MockPVZRepository) IsActiveCity(name string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsActiveCity", name)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsActiveCity indicates an expected call of IsActiveCity.
+func (mr *MockPVZRepositoryMockRecorder) IsActiveCity(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsActiveCity", reflect.TypeOf((*MockPVZRepository)(nil).IsActiveCity), name)
+}
+
+// UpdatePvz mocks base method.
+func (m *MockPVZRepository) UpdatePvz(pvzID uuid.UUID, update entity.PvzUpdate, versions []int64) (*entity.Pvz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePvz", pvzID, update, versions)
+	ret0, _ := ret[0].(*entity.Pvz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePvz indicates an expected call of UpdatePvz.
+func (mr *MockPVZRepositoryMockRecorder) UpdatePvz(pvzID, update, versions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePvz", reflect.TypeOf((*MockPVZRepository)(nil).UpdatePvz), pvzID, update, versions)
+}
+
+// UpdatePvzSettings mocks base method.
+func (m *MockPVZRepository) UpdatePvzSettings(pvzID uuid.UUID, settings entity.PvzSettings, versions []int64) (*entity.Pvz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePvzSettings", pvzID, settings, versions)
+	ret0, _ := ret[0].(*entity.Pvz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePvzSettings indicates an expected call of UpdatePvzSettings.
+func (mr *MockPVZRepositoryMockRecorder) UpdatePvzSettings(pvzID, settings, versions any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPvz", reflect.TypeOf((*MockPVZRepository)(nil).GetPvz), startDate, endDate, page, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePvzSettings", reflect.TypeOf((*MockPVZRepository)(nil).UpdatePvzSettings), pvzID, settings, versions)
 }
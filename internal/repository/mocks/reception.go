@@ -11,6 +11,7 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/alexey-shedrin/avito-test-task/internal/model/entity"
 	uuid "github.com/google/uuid"
@@ -41,19 +42,49 @@ func (m *MockReceptionRepository) EXPECT() *MockReceptionRepositoryMockRecorder
 	return m.recorder
 }
 
+// CancelReception mocks base method.
+func (m *MockReceptionRepository) CancelReception(receptionID uuid.UUID, reason string, version int64) (*entity.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelReception", receptionID, reason, version)
+	ret0, _ := ret[0].(*entity.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelReception indicates an expected call of CancelReception.
+func (mr *MockReceptionRepositoryMockRecorder) CancelReception(receptionID, reason, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReception", reflect.TypeOf((*MockReceptionRepository)(nil).CancelReception), receptionID, reason, version)
+}
+
 // CloseLastReception mocks base method.
-func (m *MockReceptionRepository) CloseLastReception(receptionId uuid.UUID) (*entity.Reception, error) {
+func (m *MockReceptionRepository) CloseLastReception(receptionId uuid.UUID, version int64) (*entity.Reception, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CloseLastReception", receptionId)
+	ret := m.ctrl.Call(m, "CloseLastReception", receptionId, version)
 	ret0, _ := ret[0].(*entity.Reception)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CloseLastReception indicates an expected call of CloseLastReception.
-func (mr *MockReceptionRepositoryMockRecorder) CloseLastReception(receptionId any) *gomock.Call {
+func (mr *MockReceptionRepositoryMockRecorder) CloseLastReception(receptionId, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseLastReception", reflect.TypeOf((*MockReceptionRepository)(nil).CloseLastReception), receptionId, version)
+}
+
+// CloseStaleReceptions mocks base method.
+func (m *MockReceptionRepository) CloseStaleReceptions(now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseStaleReceptions", now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseStaleReceptions indicates an expected call of CloseStaleReceptions.
+func (mr *MockReceptionRepositoryMockRecorder) CloseStaleReceptions(now any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseLastReception", reflect.TypeOf((*MockReceptionRepository)(nil).CloseLastReception), receptionId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseStaleReceptions", reflect.TypeOf((*MockReceptionRepository)(nil).CloseStaleReceptions), now)
 }
 
 // CreateProduct mocks base method.
@@ -71,6 +102,21 @@ func (mr *MockReceptionRepositoryMockRecorder) CreateProduct(product any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProduct", reflect.TypeOf((*MockReceptionRepository)(nil).CreateProduct), product)
 }
 
+// CreateProducts mocks base method.
+func (m *MockReceptionRepository) CreateProducts(products []*entity.Product, partial bool) ([]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProducts", products, partial)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProducts indicates an expected call of CreateProducts.
+func (mr *MockReceptionRepositoryMockRecorder) CreateProducts(products, partial any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProducts", reflect.TypeOf((*MockReceptionRepository)(nil).CreateProducts), products, partial)
+}
+
 // CreateReception mocks base method.
 func (m *MockReceptionRepository) CreateReception(reception *entity.Reception) (*entity.Reception, error) {
 	m.ctrl.T.Helper()
@@ -87,17 +133,63 @@ func (mr *MockReceptionRepositoryMockRecorder) CreateReception(reception any) *g
 }
 
 // DeleteLastProduct mocks base method.
-func (m *MockReceptionRepository) DeleteLastProduct(pvzID uuid.UUID) error {
+func (m *MockReceptionRepository) DeleteLastProduct(receptionID uuid.UUID) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteLastProduct", pvzID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "DeleteLastProduct", receptionID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // DeleteLastProduct indicates an expected call of DeleteLastProduct.
-func (mr *MockReceptionRepositoryMockRecorder) DeleteLastProduct(pvzID any) *gomock.Call {
+func (mr *MockReceptionRepositoryMockRecorder) DeleteLastProduct(receptionID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLastProduct", reflect.TypeOf((*MockReceptionRepository)(nil).DeleteLastProduct), pvzID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLastProduct", reflect.TypeOf((*MockReceptionRepository)(nil).DeleteLastProduct), receptionID)
+}
+
+// DeleteProduct mocks base method.
+func (m *MockReceptionRepository) DeleteProduct(receptionID, productID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProduct", receptionID, productID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProduct indicates an expected call of DeleteProduct.
+func (mr *MockReceptionRepositoryMockRecorder) DeleteProduct(receptionID, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockReceptionRepository)(nil).DeleteProduct), receptionID, productID)
+}
+
+// FindScannedBarcodes mocks base method.
+func (m *MockReceptionRepository) FindScannedBarcodes(receptionID uuid.UUID, barcodes []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindScannedBarcodes", receptionID, barcodes)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindScannedBarcodes indicates an expected call of FindScannedBarcodes.
+func (mr *MockReceptionRepositoryMockRecorder) FindScannedBarcodes(receptionID, barcodes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindScannedBarcodes", reflect.TypeOf((*MockReceptionRepository)(nil).FindScannedBarcodes), receptionID, barcodes)
+}
+
+// GetActiveProductTypes mocks base method.
+func (m *MockReceptionRepository) GetActiveProductTypes() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveProductTypes")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveProductTypes indicates an expected call of GetActiveProductTypes.
+func (mr *MockReceptionRepositoryMockRecorder) GetActiveProductTypes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveProductTypes", reflect.TypeOf((*MockReceptionRepository)(nil).GetActiveProductTypes))
 }
 
 // GetOpenedReceptionId mocks base method.
@@ -114,3 +206,154 @@ func (mr *MockReceptionRepositoryMockRecorder) GetOpenedReceptionId(pvzID any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenedReceptionId", reflect.TypeOf((*MockReceptionRepository)(nil).GetOpenedReceptionId), pvzID)
 }
+
+// GetProduct mocks base method.
+func (m *MockReceptionRepository) GetProduct(productID uuid.UUID) (*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProduct", productID)
+	ret0, _ := ret[0].(*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProduct indicates an expected call of GetProduct.
+func (mr *MockReceptionRepositoryMockRecorder) GetProduct(productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProduct", reflect.TypeOf((*MockReceptionRepository)(nil).GetProduct), productID)
+}
+
+// GetProductsByBarcode mocks base method.
+func (m *MockReceptionRepository) GetProductsByBarcode(barcode string) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductsByBarcode", barcode)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductsByBarcode indicates an expected call of GetProductsByBarcode.
+func (mr *MockReceptionRepositoryMockRecorder) GetProductsByBarcode(barcode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsByBarcode", reflect.TypeOf((*MockReceptionRepository)(nil).GetProductsByBarcode), barcode)
+}
+
+// GetProductsOnHand mocks base method.
+func (m *MockReceptionRepository) GetProductsOnHand(pvzID uuid.UUID, statuses []string) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductsOnHand", pvzID, statuses)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductsOnHand indicates an expected call of GetProductsOnHand.
+func (mr *MockReceptionRepositoryMockRecorder) GetProductsOnHand(pvzID, statuses any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsOnHand", reflect.TypeOf((*MockReceptionRepository)(nil).GetProductsOnHand), pvzID, statuses)
+}
+
+// GetPvzStatus mocks base method.
+func (m *MockReceptionRepository) GetPvzStatus(pvzID uuid.UUID) (string, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPvzStatus", pvzID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPvzStatus indicates an expected call of GetPvzStatus.
+func (mr *MockReceptionRepositoryMockRecorder) GetPvzStatus(pvzID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPvzStatus", reflect.TypeOf((*MockReceptionRepository)(nil).GetPvzStatus), pvzID)
+}
+
+// GetReception mocks base method.
+func (m *MockReceptionRepository) GetReception(receptionID uuid.UUID) (*entity.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReception", receptionID)
+	ret0, _ := ret[0].(*entity.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReception indicates an expected call of GetReception.
+func (mr *MockReceptionRepositoryMockRecorder) GetReception(receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReception", reflect.TypeOf((*MockReceptionRepository)(nil).GetReception), receptionID)
+}
+
+// GetRemainingProductCapacity mocks base method.
+func (m *MockReceptionRepository) GetRemainingProductCapacity(receptionID uuid.UUID) (*int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRemainingProductCapacity", receptionID)
+	ret0, _ := ret[0].(*int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRemainingProductCapacity indicates an expected call of GetRemainingProductCapacity.
+func (mr *MockReceptionRepositoryMockRecorder) GetRemainingProductCapacity(receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemainingProductCapacity", reflect.TypeOf((*MockReceptionRepository)(nil).GetRemainingProductCapacity), receptionID)
+}
+
+// GetStorageUsage mocks base method.
+func (m *MockReceptionRepository) GetStorageUsage(receptionID uuid.UUID) (*entity.StorageUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageUsage", receptionID)
+	ret0, _ := ret[0].(*entity.StorageUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageUsage indicates an expected call of GetStorageUsage.
+func (mr *MockReceptionRepositoryMockRecorder) GetStorageUsage(receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageUsage", reflect.TypeOf((*MockReceptionRepository)(nil).GetStorageUsage), receptionID)
+}
+
+// HasNewerReception mocks base method.
+func (m *MockReceptionRepository) HasNewerReception(pvzID uuid.UUID, after time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasNewerReception", pvzID, after)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasNewerReception indicates an expected call of HasNewerReception.
+func (mr *MockReceptionRepositoryMockRecorder) HasNewerReception(pvzID, after any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasNewerReception", reflect.TypeOf((*MockReceptionRepository)(nil).HasNewerReception), pvzID, after)
+}
+
+// ReopenReception mocks base method.
+func (m *MockReceptionRepository) ReopenReception(receptionID uuid.UUID, version int64, closedAfter time.Time) (*entity.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReopenReception", receptionID, version, closedAfter)
+	ret0, _ := ret[0].(*entity.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReopenReception indicates an expected call of ReopenReception.
+func (mr *MockReceptionRepositoryMockRecorder) ReopenReception(receptionID, version, closedAfter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReopenReception", reflect.TypeOf((*MockReceptionRepository)(nil).ReopenReception), receptionID, version, closedAfter)
+}
+
+// UpdateProductStatus mocks base method.
+func (m *MockReceptionRepository) UpdateProductStatus(productID uuid.UUID, from, to string, at time.Time) (*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductStatus", productID, from, to, at)
+	ret0, _ := ret[0].(*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProductStatus indicates an expected call of UpdateProductStatus.
+func (mr *MockReceptionRepositoryMockRecorder) UpdateProductStatus(productID, from, to, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductStatus", reflect.TypeOf((*MockReceptionRepository)(nil).UpdateProductStatus), productID, from, to, at)
+}
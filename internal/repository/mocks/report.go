@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/service/report.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/service/report.go -destination=internal/repository/mocks/report.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	request "github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
+	response "github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReportRepository is a mock of ReportRepository interface.
+type MockReportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReportRepositoryMockRecorder is the mock recorder for MockReportRepository.
+type MockReportRepositoryMockRecorder struct {
+	mock *MockReportRepository
+}
+
+// NewMockReportRepository creates a new mock instance.
+func NewMockReportRepository(ctrl *gomock.Controller) *MockReportRepository {
+	mock := &MockReportRepository{ctrl: ctrl}
+	mock.recorder = &MockReportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportRepository) EXPECT() *MockReportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// BuildDailyReport mocks base method.
+func (m *MockReportRepository) BuildDailyReport(date time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildDailyReport", date)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BuildDailyReport indicates an expected call of BuildDailyReport.
+func (mr *MockReportRepositoryMockRecorder) BuildDailyReport(date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildDailyReport", reflect.TypeOf((*MockReportRepository)(nil).BuildDailyReport), date)
+}
+
+// ExportReceptions mocks base method.
+func (m *MockReportRepository) ExportReceptions(req *request.Export, fn func(*response.ExportRow) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportReceptions", req, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportReceptions indicates an expected call of ExportReceptions.
+func (mr *MockReportRepositoryMockRecorder) ExportReceptions(req, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportReceptions", reflect.TypeOf((*MockReportRepository)(nil).ExportReceptions), req, fn)
+}
+
+// GetDailyReport mocks base method.
+func (m *MockReportRepository) GetDailyReport(date time.Time) ([]response.DailyReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDailyReport", date)
+	ret0, _ := ret[0].([]response.DailyReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDailyReport indicates an expected call of GetDailyReport.
+func (mr *MockReportRepositoryMockRecorder) GetDailyReport(date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDailyReport", reflect.TypeOf((*MockReportRepository)(nil).GetDailyReport), date)
+}
+
+// GetMissingDailyReportDates mocks base method.
+func (m *MockReportRepository) GetMissingDailyReportDates(from, to time.Time) ([]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMissingDailyReportDates", from, to)
+	ret0, _ := ret[0].([]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMissingDailyReportDates indicates an expected call of GetMissingDailyReportDates.
+func (mr *MockReportRepositoryMockRecorder) GetMissingDailyReportDates(from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMissingDailyReportDates", reflect.TypeOf((*MockReportRepository)(nil).GetMissingDailyReportDates), from, to)
+}
+
+// GetStats mocks base method.
+func (m *MockReportRepository) GetStats(req *request.Stats) (*response.Stats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStats", req)
+	ret0, _ := ret[0].(*response.Stats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStats indicates an expected call of GetStats.
+func (mr *MockReportRepositoryMockRecorder) GetStats(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStats", reflect.TypeOf((*MockReportRepository)(nil).GetStats), req)
+}
@@ -0,0 +1,164 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/service/shipment.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/service/shipment.go -destination=internal/repository/mocks/shipment.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockShipmentRepository is a mock of ShipmentRepository interface.
+type MockShipmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockShipmentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockShipmentRepositoryMockRecorder is the mock recorder for MockShipmentRepository.
+type MockShipmentRepositoryMockRecorder struct {
+	mock *MockShipmentRepository
+}
+
+// NewMockShipmentRepository creates a new mock instance.
+func NewMockShipmentRepository(ctrl *gomock.Controller) *MockShipmentRepository {
+	mock := &MockShipmentRepository{ctrl: ctrl}
+	mock.recorder = &MockShipmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShipmentRepository) EXPECT() *MockShipmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AttachProduct mocks base method.
+func (m *MockShipmentRepository) AttachProduct(shipmentID, productID uuid.UUID) (*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachProduct", shipmentID, productID)
+	ret0, _ := ret[0].(*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachProduct indicates an expected call of AttachProduct.
+func (mr *MockShipmentRepositoryMockRecorder) AttachProduct(shipmentID, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachProduct", reflect.TypeOf((*MockShipmentRepository)(nil).AttachProduct), shipmentID, productID)
+}
+
+// CloseShipment mocks base method.
+func (m *MockShipmentRepository) CloseShipment(shipmentID uuid.UUID, at time.Time) (*entity.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseShipment", shipmentID, at)
+	ret0, _ := ret[0].(*entity.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseShipment indicates an expected call of CloseShipment.
+func (mr *MockShipmentRepositoryMockRecorder) CloseShipment(shipmentID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShipment", reflect.TypeOf((*MockShipmentRepository)(nil).CloseShipment), shipmentID, at)
+}
+
+// CreateShipment mocks base method.
+func (m *MockShipmentRepository) CreateShipment(shipment *entity.Shipment) (*entity.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShipment", shipment)
+	ret0, _ := ret[0].(*entity.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShipment indicates an expected call of CreateShipment.
+func (mr *MockShipmentRepositoryMockRecorder) CreateShipment(shipment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShipment", reflect.TypeOf((*MockShipmentRepository)(nil).CreateShipment), shipment)
+}
+
+// DetachProduct mocks base method.
+func (m *MockShipmentRepository) DetachProduct(shipmentID, productID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachProduct", shipmentID, productID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetachProduct indicates an expected call of DetachProduct.
+func (mr *MockShipmentRepositoryMockRecorder) DetachProduct(shipmentID, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachProduct", reflect.TypeOf((*MockShipmentRepository)(nil).DetachProduct), shipmentID, productID)
+}
+
+// GetOpenedShipmentId mocks base method.
+func (m *MockShipmentRepository) GetOpenedShipmentId(pvzID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenedShipmentId", pvzID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenedShipmentId indicates an expected call of GetOpenedShipmentId.
+func (mr *MockShipmentRepositoryMockRecorder) GetOpenedShipmentId(pvzID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenedShipmentId", reflect.TypeOf((*MockShipmentRepository)(nil).GetOpenedShipmentId), pvzID)
+}
+
+// GetProduct mocks base method.
+func (m *MockShipmentRepository) GetProduct(productID uuid.UUID) (*entity.Product, uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProduct", productID)
+	ret0, _ := ret[0].(*entity.Product)
+	ret1, _ := ret[1].(uuid.UUID)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetProduct indicates an expected call of GetProduct.
+func (mr *MockShipmentRepositoryMockRecorder) GetProduct(productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProduct", reflect.TypeOf((*MockShipmentRepository)(nil).GetProduct), productID)
+}
+
+// GetShipment mocks base method.
+func (m *MockShipmentRepository) GetShipment(shipmentID uuid.UUID) (*entity.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShipment", shipmentID)
+	ret0, _ := ret[0].(*entity.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShipment indicates an expected call of GetShipment.
+func (mr *MockShipmentRepositoryMockRecorder) GetShipment(shipmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShipment", reflect.TypeOf((*MockShipmentRepository)(nil).GetShipment), shipmentID)
+}
+
+// GetShipmentProducts mocks base method.
+func (m *MockShipmentRepository) GetShipmentProducts(shipmentID uuid.UUID) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShipmentProducts", shipmentID)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShipmentProducts indicates an expected call of GetShipmentProducts.
+func (mr *MockShipmentRepositoryMockRecorder) GetShipmentProducts(shipmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShipmentProducts", reflect.TypeOf((*MockShipmentRepository)(nil).GetShipmentProducts), shipmentID)
+}
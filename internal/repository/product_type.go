@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/database"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+)
+
+var (
+	ErrProductTypeAlreadyExists = apperror.Conflict("product_type_already_exists", "product type already exists")
+	ErrProductTypeNotFound      = apperror.NotFound("product_type_not_found", "product type not found")
+)
+
+type ProductTypeRepository struct {
+	db *sql.DB
+}
+
+func NewProductTypeRepository(db *sql.DB) *ProductTypeRepository {
+	return &ProductTypeRepository{
+		db: db,
+	}
+}
+
+func (r *ProductTypeRepository) CreateProductType(productType *entity.ProductType) (*entity.ProductType, error) {
+	log.SetPrefix("repository.CreateProductType")
+	query := `INSERT INTO product_type (name, created_at) VALUES ($1, $2)`
+
+	productType.CreatedAt = time.Now().UTC()
+	productType.Deprecated = false
+
+	if _, err := r.db.Exec(query, productType.Name, productType.CreatedAt); err != nil {
+		if database.IsUniqueViolation(err) {
+			return nil, ErrProductTypeAlreadyExists
+		}
+
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	return productType, nil
+}
+
+func (r *ProductTypeRepository) GetProductTypes(includeDeprecated bool) ([]*entity.ProductType, error) {
+	log.SetPrefix("repository.GetProductTypes")
+	query := `SELECT name, deprecated, created_at FROM product_type WHERE $1 OR NOT deprecated ORDER BY name`
+
+	rows, err := r.db.Query(query, includeDeprecated)
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+	defer rows.Close()
+
+	productTypes := make([]*entity.ProductType, 0)
+	for rows.Next() {
+		var productType entity.ProductType
+		if err = rows.Scan(&productType.Name, &productType.Deprecated, &productType.CreatedAt); err != nil {
+			log.Printf("error: %v", err)
+
+			return nil, err
+		}
+
+		productTypes = append(productTypes, &productType)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	return productTypes, nil
+}
+
+func (r *ProductTypeRepository) SetProductTypeDeprecated(name string, deprecated bool) (*entity.ProductType, error) {
+	log.SetPrefix("repository.SetProductTypeDeprecated")
+	query := `UPDATE product_type SET deprecated = $2 WHERE name = $1 RETURNING name, deprecated, created_at`
+
+	var productType entity.ProductType
+	err := r.db.QueryRow(query, name, deprecated).Scan(&productType.Name, &productType.Deprecated, &productType.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrProductTypeNotFound
+		}
+
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	return &productType, nil
+}
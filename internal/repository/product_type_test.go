@@ -0,0 +1,85 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/alexey-shedrin/avito-test-task/internal/repository"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateProductType(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewProductTypeRepository(db)
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec("INSERT INTO product_type \\(name, created_at\\)").
+			WithArgs("косметика", sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		productType, err := repo.CreateProductType(&entity.ProductType{Name: "косметика"})
+
+		require.NoError(t, err)
+		require.Equal(t, "косметика", productType.Name)
+		require.False(t, productType.Deprecated)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("AlreadyExists", func(t *testing.T) {
+		mock.ExpectExec("INSERT INTO product_type \\(name, created_at\\)").
+			WithArgs("обувь", sqlmock.AnyArg()).
+			WillReturnError(&pq.Error{Code: "23505"})
+
+		productType, err := repo.CreateProductType(&entity.ProductType{Name: "обувь"})
+
+		require.Equal(t, repository.ErrProductTypeAlreadyExists, err)
+		require.Nil(t, productType)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestGetProductTypes(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewProductTypeRepository(db)
+
+	mock.ExpectQuery("SELECT name, deprecated, created_at FROM product_type WHERE \\$1 OR NOT deprecated").
+		WithArgs(true).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "deprecated", "created_at"}).
+			AddRow("обувь", false, time.Now()).
+			AddRow("хозтовары", true, time.Now()))
+
+	productTypes, err := repo.GetProductTypes(true)
+
+	require.NoError(t, err)
+	require.Len(t, productTypes, 2)
+	require.True(t, productTypes[1].Deprecated)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetProductTypeDeprecated_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewProductTypeRepository(db)
+
+	mock.ExpectQuery("UPDATE product_type SET deprecated = \\$2 WHERE name = \\$1").
+		WithArgs("мебель", true).
+		WillReturnError(sql.ErrNoRows)
+
+	productType, err := repo.SetProductTypeDeprecated("мебель", true)
+
+	require.Equal(t, repository.ErrProductTypeNotFound, err)
+	require.Nil(t, productType)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
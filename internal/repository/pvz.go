@@ -3,18 +3,76 @@ package repository
 import (
 	"database/sql"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 var (
-	ErrPvzNotFound = errors.New("pvz not found")
+	ErrPvzNotFound = apperror.NotFound("pvz_not_found", "pvz not found")
+	ErrInvalidSort = apperror.Validation("invalid_sort", "invalid sort parameters")
 )
 
+// pvzSortColumns и sortOrders - единственный список допустимых параметров сортировки:
+// в запрос подставляются только значения из них.
+var (
+	pvzSortColumns = map[string]string{
+		entity.PvzSortRegistrationDate: "registration_date",
+		entity.PvzSortCity:             "city",
+		entity.PvzSortLastReception:    "last_reception",
+		entity.PvzSortProductCount:     "product_count",
+	}
+	sortOrders = map[string]string{
+		entity.SortOrderAsc:  "ASC",
+		entity.SortOrderDesc: "DESC",
+	}
+)
+
+const pvzColumns = `id, city, registration_date, address, latitude, longitude, opening_hours, phone, status,
+            archived, archived_at, (SELECT c.timezone FROM city c WHERE c.name = pvz.city),
+            max_products_per_reception, max_open_minutes, max_storage_volume_cm3, storage_limit_mode, version`
+
+// scanPvz читает строку, выбранную по pvzColumns.
+func scanPvz(row *sql.Row) (*entity.Pvz, error) {
+	var pvz entity.Pvz
+
+	err := row.Scan(
+		&pvz.Id, &pvz.City, &pvz.RegistrationDate, &pvz.Address, &pvz.Latitude, &pvz.Longitude,
+		&pvz.OpeningHours, &pvz.Phone, &pvz.Status, &pvz.Archived, &pvz.ArchivedAt, &pvz.Timezone,
+		&pvz.Settings.MaxProductsPerReception, &pvz.Settings.MaxOpenMinutes,
+		&pvz.Settings.MaxStorageVolumeCm3, &pvz.Settings.StorageLimitMode, &pvz.Version,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPvzNotFound
+		}
+
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	return &pvz, nil
+}
+
+// pvzInfoColumns поля ПВЗ в выборках списка, читаются через pvzInfoDest.
+const pvzInfoColumns = `fp.id, fp.city, fp.registration_date, fp.address, fp.latitude, fp.longitude,
+            fp.opening_hours, fp.phone, fp.status, fp.archived, fp.archived_at, fp.timezone, fp.version`
+
+func pvzInfoDest(pvz *entity.Pvz) []any {
+	return []any{
+		&pvz.Id, &pvz.City, &pvz.RegistrationDate, &pvz.Address, &pvz.Latitude, &pvz.Longitude,
+		&pvz.OpeningHours, &pvz.Phone, &pvz.Status, &pvz.Archived, &pvz.ArchivedAt, &pvz.Timezone, &pvz.Version,
+	}
+}
+
 type PVZRepository struct {
 	db *sql.DB
 }
@@ -28,55 +86,401 @@ func NewPVZRepository(db *sql.DB) *PVZRepository {
 func (r *PVZRepository) CreatePvz(pvz *entity.Pvz) (*entity.Pvz, error) {
 	log.SetPrefix("repository.CreatePvz")
 
-	query := `INSERT INTO pvz (id, registration_date, city) VALUES ($1, $2, $3)`
+	query := `INSERT INTO pvz (id, registration_date, city, status) VALUES ($1, $2, $3, $4) RETURNING ` + pvzColumns
+
+	return scanPvz(r.db.QueryRow(query, uuid.New(), time.Now().UTC(), pvz.City, entity.PvzStatusActive))
+}
+
+func (r *PVZRepository) IsActiveCity(name string) (bool, error) {
+	log.SetPrefix("repository.IsActiveCity")
 
-	pvz.Id = uuid.New()
-	pvz.RegistrationDate = time.Now()
+	query := `SELECT EXISTS (SELECT 1 FROM city WHERE name = $1 AND active)`
 
-	if _, err := r.db.Exec(query, pvz.Id, pvz.RegistrationDate, pvz.City); err != nil {
+	var active bool
+	if err := r.db.QueryRow(query, name).Scan(&active); err != nil {
 		log.Printf("error: %v", err)
 
+		return false, err
+	}
+
+	return active, nil
+}
+
+// UpdatePvzSettings меняет ограничения ПВЗ. Если versions заданы, а версия ПВЗ
+// ни с одной из них не совпадает, возвращает nil.
+func (r *PVZRepository) UpdatePvzSettings(pvzID uuid.UUID, settings entity.PvzSettings, versions []int64) (*entity.Pvz, error) {
+	log.SetPrefix("repository.UpdatePvzSettings")
+
+	query := `
+        UPDATE pvz SET
+            max_products_per_reception = $2,
+            max_open_minutes = $3,
+            max_storage_volume_cm3 = $4,
+            storage_limit_mode = $5,
+            version = version + 1
+        WHERE id = $1 AND NOT archived AND ($6::bigint[] IS NULL OR version = ANY($6))
+        RETURNING ` + pvzColumns
+
+	pvz, err := scanPvz(r.db.QueryRow(query, pvzID,
+		settings.MaxProductsPerReception, settings.MaxOpenMinutes, settings.MaxStorageVolumeCm3, settings.StorageLimitMode,
+		pq.Array(versions),
+	))
+
+	return r.checkVersion(pvzID, versions, pvz, err)
+}
+
+// UpdatePvz меняет данные ПВЗ. Если versions заданы, а версия ПВЗ ни с одной из них не совпадает, возвращает nil.
+func (r *PVZRepository) UpdatePvz(pvzID uuid.UUID, update entity.PvzUpdate, versions []int64) (*entity.Pvz, error) {
+	log.SetPrefix("repository.UpdatePvz")
+
+	query := `
+        UPDATE pvz SET
+            address = COALESCE($2, address),
+            latitude = COALESCE($3, latitude),
+            longitude = COALESCE($4, longitude),
+            opening_hours = COALESCE($5, opening_hours),
+            phone = COALESCE($6, phone),
+            status = COALESCE($7, status),
+            version = version + 1
+        WHERE id = $1 AND NOT archived AND ($8::bigint[] IS NULL OR version = ANY($8))
+        RETURNING ` + pvzColumns
+
+	pvz, err := scanPvz(r.db.QueryRow(query, pvzID,
+		update.Address, update.Latitude, update.Longitude, update.OpeningHours, update.Phone, update.Status,
+		pq.Array(versions),
+	))
+
+	return r.checkVersion(pvzID, versions, pvz, err)
+}
+
+// checkVersion отличает несовпадение версии от отсутствия ПВЗ, когда UPDATE не затронул строк.
+func (r *PVZRepository) checkVersion(pvzID uuid.UUID, versions []int64, pvz *entity.Pvz, err error) (*entity.Pvz, error) {
+	if versions == nil || !errors.Is(err, ErrPvzNotFound) {
+		return pvz, err
+	}
+
+	exists, err := r.pvzExists(pvzID)
+	if err != nil {
 		return nil, err
 	}
 
-	return pvz, nil
+	if !exists {
+		return nil, ErrPvzNotFound
+	}
+
+	return nil, nil
 }
 
-func (r *PVZRepository) GetPvz(startDate, endDate *time.Time, page, limit *int) ([]response.PvzInfo, error) {
-	log.SetPrefix("repository.PvzInfo")
+func (r *PVZRepository) pvzExists(pvzID uuid.UUID) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM pvz WHERE id = $1 AND NOT archived)`
+
+	var exists bool
+	if err := r.db.QueryRow(query, pvzID).Scan(&exists); err != nil {
+		log.Printf("error: %v", err)
+
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// GetNearbyPvz ищет активные ПВЗ в радиусе req.Radius метров. Условие earth_box
+// использует индекс idx_pvz_location, earth_distance отсекает углы куба.
+func (r *PVZRepository) GetNearbyPvz(req *request.GetNearbyPvz) ([]response.NearbyPvz, error) {
+	log.SetPrefix("repository.GetNearbyPvz")
+
+	query := `
+        SELECT 
+            p.id, p.city, p.registration_date, p.address, p.latitude, p.longitude,
+            p.opening_hours, p.phone, p.status, p.archived, p.archived_at,
+            (SELECT c.timezone FROM city c WHERE c.name = p.city), p.version,
+            earth_distance(ll_to_earth($1, $2), ll_to_earth(p.latitude, p.longitude)) AS distance
+        FROM 
+            pvz p
+        WHERE 
+            p.status = 'active' AND NOT p.archived
+            AND p.latitude IS NOT NULL AND p.longitude IS NOT NULL
+            AND earth_box(ll_to_earth($1, $2), $3) @> ll_to_earth(p.latitude, p.longitude)
+            AND earth_distance(ll_to_earth($1, $2), ll_to_earth(p.latitude, p.longitude)) <= $3
+        ORDER BY 
+            distance, p.id
+        LIMIT $4
+    `
+
+	rows, err := r.db.Query(query, req.Latitude, req.Longitude, req.Radius, req.Limit)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]response.NearbyPvz, 0)
+	for rows.Next() {
+		var pvz entity.Pvz
+		var distance float64
+
+		if err = rows.Scan(append(pvzInfoDest(&pvz), &distance)...); err != nil {
+			log.Printf("error: %v", err)
+			return nil, err
+		}
+
+		result = append(result, response.NearbyPvz{Pvz: pvz.ToResponse(), Distance: distance})
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *PVZRepository) HasOpenedReception(pvzID uuid.UUID) (bool, error) {
+	log.SetPrefix("repository.HasOpenedReception")
+
+	query := `SELECT EXISTS (SELECT 1 FROM reception WHERE pvz_id = $1 AND status = $2)`
+
+	var exists bool
+	if err := r.db.QueryRow(query, pvzID, entity.ReceptionStatusInProgress).Scan(&exists); err != nil {
+		log.Printf("error: %v", err)
+
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// ArchivePvz мягко удаляет ПВЗ: строка и вся история приемок остаются в базе. ПВЗ с открытой
+// приемкой не архивируется. Возвращает false, если в ПВЗ открыта приемка или versions заданы,
+// а версия ПВЗ ни с одной из них не совпадает.
+func (r *PVZRepository) ArchivePvz(pvzID uuid.UUID, at time.Time, versions []int64) (bool, error) {
+	log.SetPrefix("repository.ArchivePvz")
 
 	query := `
-        WITH filtered_receptions AS (
+        UPDATE pvz SET archived = true, archived_at = $2, version = version + 1
+        WHERE id = $1 AND NOT archived AND ($3::bigint[] IS NULL OR version = ANY($3))
+            AND NOT EXISTS (SELECT 1 FROM reception r WHERE r.pvz_id = pvz.id AND r.status = 'in_progress')`
+
+	res, err := r.db.Exec(query, pvzID, at, pq.Array(versions))
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return false, err
+	}
+
+	if affected > 0 {
+		return true, nil
+	}
+
+	exists, err := r.pvzExists(pvzID)
+	if err != nil {
+		return false, err
+	}
+
+	if exists {
+		return false, nil
+	}
+
+	return false, ErrPvzNotFound
+}
+
+// filteredPvzQuery выбирает страницу ПВЗ со счетчиками приемок за период. %[3]s - вид
+// соединения с приемками: для "JOIN" в выборку попадают только ПВЗ с приемками за период,
+// для "LEFT JOIN" - все ПВЗ.
+const filteredPvzQuery = `
+        WITH filtered_pvz AS (
             SELECT 
-                r.pvz_id
+                p.id, p.city, p.registration_date, p.address, p.latitude, p.longitude,
+                p.opening_hours, p.phone, p.status, p.archived, p.archived_at, p.version,
+                (SELECT c.timezone FROM city c WHERE c.name = p.city) AS timezone,
+                MAX(r.reception_datetime) AS last_reception,
+                COUNT(DISTINCT r.id) AS reception_count,
+                COUNT(pr.id) AS product_count
             FROM 
-                reception r
+                pvz p
+            %[3]s 
+                reception r ON p.id = r.pvz_id AND 
+                ($1::timestamptz IS NULL OR r.reception_datetime >= $1) AND 
+                ($2::timestamptz IS NULL OR r.reception_datetime <= $2)
+            LEFT JOIN 
+                product pr ON r.id = pr.reception_id
             WHERE 
-                ($1::timestamp IS NULL OR r.reception_datetime >= $1) AND 
-                ($2::timestamp IS NULL OR r.reception_datetime <= $2)
+                ($5 OR NOT p.archived)
             GROUP BY 
-                r.pvz_id
-        )
+                p.id
+            ORDER BY 
+                %[1]s %[2]s, p.id
+            LIMIT $3 OFFSET $4
+        )`
+
+func (r *PVZRepository) GetPvz(req *request.GetPvz) ([]response.PvzInfo, error) {
+	log.SetPrefix("repository.PvzInfo")
+
+	sortColumn, ok := pvzSortColumns[req.Sort]
+	if !ok {
+		return nil, ErrInvalidSort
+	}
+
+	sortOrder, ok := sortOrders[req.Order]
+	if !ok {
+		return nil, ErrInvalidSort
+	}
+
+	offset := 0
+	if req.Page != nil && req.Limit != nil {
+		offset = (*req.Page - 1) * (*req.Limit)
+	}
+
+	args := []any{req.StartDate, req.EndDate, req.Limit, offset, req.IncludeArchived}
+
+	switch req.View {
+	case entity.PvzViewSummary:
+		return r.getPvzSummary(sortColumn, sortOrder, args)
+	case entity.PvzViewWithReceptions:
+		return r.getPvzWithReceptions(sortColumn, sortOrder, args)
+	default:
+		return r.getPvzFull(sortColumn, sortOrder, args)
+	}
+}
+
+// getPvzSummary перечисляет все ПВЗ, в том числе без приемок за период, со счетчиками
+// приемок и товаров за период. Количество товаров по каждой приемке отдает with_receptions.
+func (r *PVZRepository) getPvzSummary(sortColumn, sortOrder string, args []any) ([]response.PvzInfo, error) {
+	query := fmt.Sprintf(filteredPvzQuery+`
         SELECT 
-            p.id, p.city, p.registration_date,
-            r.id, r.reception_datetime, r.status, r.pvz_id,
-            pr.id, pr.acceptance_datetime, pr.product_type, pr.reception_id
+            `+pvzInfoColumns+`, fp.reception_count, fp.product_count
         FROM 
-            pvz p
+            filtered_pvz fp
+        ORDER BY 
+            fp.%[1]s %[2]s, fp.id
+    `, sortColumn, sortOrder, "LEFT JOIN")
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]response.PvzInfo, 0)
+
+	for rows.Next() {
+		var pvz entity.Pvz
+		var receptionCount, productCount int
+
+		err = rows.Scan(append(pvzInfoDest(&pvz), &receptionCount, &productCount)...)
+		if err != nil {
+			log.Printf("error: %v", err)
+			return nil, err
+		}
+
+		result = append(result, response.PvzInfo{
+			Pvz:            pvz.ToResponse(),
+			ReceptionCount: &receptionCount,
+			ProductCount:   &productCount,
+		})
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *PVZRepository) getPvzWithReceptions(sortColumn, sortOrder string, args []any) ([]response.PvzInfo, error) {
+	query := fmt.Sprintf(filteredPvzQuery+`
+        SELECT 
+            `+pvzInfoColumns+`,
+            r.id, r.reception_datetime, r.status, r.pvz_id, r.closed_at, r.version,
+            (SELECT COUNT(*) FROM product pr WHERE pr.reception_id = r.id),
+            (SELECT COALESCE(SUM(pr.weight_grams), 0) FROM product pr WHERE pr.reception_id = r.id),
+            (SELECT COALESCE(SUM(`+productVolume+`), 0) FROM product pr WHERE pr.reception_id = r.id)
+        FROM 
+            filtered_pvz fp
         JOIN 
-            filtered_receptions fr ON p.id = fr.pvz_id
+            reception r ON fp.id = r.pvz_id
+        ORDER BY 
+            fp.%[1]s %[2]s, fp.id, r.reception_datetime
+    `, sortColumn, sortOrder, "JOIN")
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make([]response.PvzInfo, 0)
+
+	for rows.Next() {
+		var pvz entity.Pvz
+		var reception entity.Reception
+		var productCount int
+		var totalWeight, totalVolume int64
+
+		err = rows.Scan(append(pvzInfoDest(&pvz),
+			&reception.Id, &reception.DateTime, &reception.Status, &reception.PvzId, &reception.ClosedAt, &reception.Version,
+			&productCount, &totalWeight, &totalVolume,
+		)...)
+		if err != nil {
+			log.Printf("error: %v", err)
+			return nil, err
+		}
+
+		reception.Timezone = pvz.Timezone
+
+		if len(result) == 0 || result[len(result)-1].Pvz.Id != pvz.Id {
+			result = append(result, response.PvzInfo{
+				Pvz:        pvz.ToResponse(),
+				Receptions: []response.ReceptionsWithProducts{},
+			})
+		}
+
+		last := &result[len(result)-1]
+		last.Receptions = append(last.Receptions, response.ReceptionsWithProducts{
+			Reception:        reception.ToResponse(),
+			ProductCount:     productCount,
+			TotalWeightGrams: totalWeight,
+			TotalVolumeCm3:   totalVolume,
+		})
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("error: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *PVZRepository) getPvzFull(sortColumn, sortOrder string, args []any) ([]response.PvzInfo, error) {
+	query := fmt.Sprintf(filteredPvzQuery+`
+        SELECT 
+            `+pvzInfoColumns+`,
+            r.id, r.reception_datetime, r.status, r.pvz_id, r.closed_at, r.version,
+            pr.id, pr.acceptance_datetime, pr.product_type, pr.reception_id, pr.barcode,
+            pr.weight_grams, pr.length_cm, pr.width_cm, pr.height_cm,
+            pr.status, pr.stored_at, pr.issued_at, pr.returned_at, pr.shipment_id
+        FROM 
+            filtered_pvz fp
         LEFT JOIN 
-            reception r ON p.id = r.pvz_id
+            reception r ON fp.id = r.pvz_id
         LEFT JOIN 
             product pr ON r.id = pr.reception_id
         ORDER BY 
-            p.id, r.reception_datetime, pr.acceptance_datetime
-        LIMIT $3 OFFSET $4
-    `
-
-	offset := (*page - 1) * (*limit)
+            fp.%[1]s %[2]s, fp.id, r.reception_datetime, pr.acceptance_datetime
+    `, sortColumn, sortOrder, "JOIN")
 
-	rows, err := r.db.Query(query, startDate, endDate, limit, offset)
+	rows, err := r.db.Query(query, args...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrPvzNotFound
@@ -95,51 +499,60 @@ func (r *PVZRepository) GetPvz(startDate, endDate *time.Time, page, limit *int)
 	result := make([]*response.PvzInfo, 0)
 
 	for rows.Next() {
-		var pvzID, receptionID, receptionPVZID, productID, productReceptionID uuid.UUID
-		var pvzCity, receptionStatus, productType string
-		var pvzRegistrationDate, receptionDateTime, productDateTime time.Time
-
-		err = rows.Scan(
-			&pvzID, &pvzCity, &pvzRegistrationDate,
-			&receptionID, &receptionDateTime, &receptionStatus, &receptionPVZID,
-			&productID, &productDateTime, &productType, &productReceptionID,
-		)
+		var pvz entity.Pvz
+		var receptionID, receptionPVZID, productID, productReceptionID uuid.UUID
+		var receptionStatus string
+		var receptionDateTime time.Time
+		var receptionClosedAt *time.Time
+		var receptionVersion sql.NullInt64
+		var productType, productBarcode sql.NullString
+		var productDateTime sql.NullTime
+		var productWeight *int
+		var productDims [3]sql.NullInt64
+		var productStatus sql.NullString
+		var productStoredAt, productIssuedAt, productReturnedAt *time.Time
+		var productShipmentID *uuid.UUID
+
+		err = rows.Scan(append(pvzInfoDest(&pvz),
+			&receptionID, &receptionDateTime, &receptionStatus, &receptionPVZID, &receptionClosedAt, &receptionVersion,
+			&productID, &productDateTime, &productType, &productReceptionID, &productBarcode,
+			&productWeight, &productDims[0], &productDims[1], &productDims[2],
+			&productStatus, &productStoredAt, &productIssuedAt, &productReturnedAt, &productShipmentID,
+		)...)
 		if err != nil {
 			log.Printf("error: %v", err)
 			return nil, err
 		}
 
 		// Обработка PVZ
-		if _, exists := pvzMap[pvzID]; !exists {
-			pvz := response.Pvz{
-				Id:               pvzID,
-				RegistrationDate: pvzRegistrationDate,
-				City:             pvzCity,
-			}
+		if _, exists := pvzMap[pvz.Id]; !exists {
 			pvzInfo := &response.PvzInfo{
-				Pvz:        pvz,
+				Pvz:        pvz.ToResponse(),
 				Receptions: []response.ReceptionsWithProducts{},
 			}
-			pvzMap[pvzID] = &tempPvzInfo{
+			pvzMap[pvz.Id] = &tempPvzInfo{
 				pvzInfo:       pvzInfo,
 				receptionsMap: make(map[uuid.UUID]*response.ReceptionsWithProducts),
 			}
 			result = append(result, pvzInfo)
 		}
 
-		tempPVZ := pvzMap[pvzID]
+		tempPVZ := pvzMap[pvz.Id]
 
 		// Обработка Reception
 		if receptionID != uuid.Nil {
 			if _, exists := tempPVZ.receptionsMap[receptionID]; !exists {
-				reception := response.Reception{
+				reception := entity.Reception{
 					Id:       receptionID,
 					DateTime: receptionDateTime,
 					PvzId:    receptionPVZID,
 					Status:   receptionStatus,
+					ClosedAt: receptionClosedAt,
+					Timezone: pvz.Timezone,
+					Version:  receptionVersion.Int64,
 				}
 				receptionWithProducts := response.ReceptionsWithProducts{
-					Reception: reception,
+					Reception: reception.ToResponse(),
 					Products:  []response.Product{},
 				}
 				tempPVZ.pvzInfo.Receptions = append(tempPVZ.pvzInfo.Receptions, receptionWithProducts)
@@ -149,16 +562,31 @@ func (r *PVZRepository) GetPvz(startDate, endDate *time.Time, page, limit *int)
 
 			// Обработка Product
 			if productID != uuid.Nil {
-				product := response.Product{
+				product := entity.Product{
 					Id:          productID,
-					DateTime:    productDateTime,
-					Type:        productType,
+					DateTime:    productDateTime.Time,
+					Type:        productType.String,
 					ReceptionId: productReceptionID,
+					WeightGrams: productWeight,
+					Status:      productStatus.String,
+					StoredAt:    productStoredAt,
+					IssuedAt:    productIssuedAt,
+					ReturnedAt:  productReturnedAt,
+					ShipmentId:  productShipmentID,
+					Timezone:    pvz.Timezone,
+				}
+				if productBarcode.Valid {
+					product.Barcode = &productBarcode.String
+				}
+				setDimensions(&product, productDims)
+
+				receptionWithProducts := tempPVZ.receptionsMap[receptionID]
+				receptionWithProducts.Products = append(receptionWithProducts.Products, *product.ToResponse())
+				receptionWithProducts.ProductCount++
+				if product.WeightGrams != nil {
+					receptionWithProducts.TotalWeightGrams += int64(*product.WeightGrams)
 				}
-				tempPVZ.receptionsMap[receptionID].Products = append(
-					tempPVZ.receptionsMap[receptionID].Products,
-					product,
-				)
+				receptionWithProducts.TotalVolumeCm3 += product.VolumeCm3()
 			}
 		}
 	}
@@ -4,11 +4,14 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
 	"github.com/alexey-shedrin/avito-test-task/internal/repository"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -31,6 +34,11 @@ func (s *PVZRepositoryTestSuite) TearDownTest() {
 	s.db.Close()
 }
 
+var pvzColumns = []string{
+	"id", "city", "registration_date", "address", "latitude", "longitude", "opening_hours", "phone", "status", "archived", "archived_at", "timezone",
+	"max_products_per_reception", "max_open_minutes", "max_storage_volume_cm3", "storage_limit_mode", "version",
+}
+
 func TestPVZRepositorySuite(t *testing.T) {
 	suite.Run(t, new(PVZRepositoryTestSuite))
 }
@@ -39,17 +47,21 @@ func (s *PVZRepositoryTestSuite) TestCreatePvz_Success() {
 	pvz := &entity.Pvz{
 		City: "Moscow",
 	}
+	pvzID := uuid.New()
+	now := time.Now().UTC()
 
-	s.mock.ExpectExec("INSERT INTO pvz \\(id, registration_date, city\\) VALUES \\(\\$1, \\$2, \\$3\\)").
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), pvz.City).
-		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectQuery("INSERT INTO pvz \\(id, registration_date, city, status\\) VALUES \\(\\$1, \\$2, \\$3, \\$4\\) RETURNING").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), pvz.City, entity.PvzStatusActive).
+		WillReturnRows(sqlmock.NewRows(pvzColumns).
+			AddRow(pvzID, pvz.City, now, nil, nil, nil, nil, nil, entity.PvzStatusActive, false, nil, "Europe/Moscow", nil, nil, nil, "reject", 1))
 
 	result, err := s.repo.CreatePvz(pvz)
 
 	require.NoError(s.T(), err)
 	require.NotNil(s.T(), result)
 	require.Equal(s.T(), pvz.City, result.City)
-	require.NotEqual(s.T(), uuid.UUID{}, result.Id)
+	require.Equal(s.T(), pvzID, result.Id)
+	require.Equal(s.T(), "Europe/Moscow", result.Timezone)
 	require.NotZero(s.T(), result.RegistrationDate)
 	require.NoError(s.T(), s.mock.ExpectationsWereMet())
 }
@@ -60,8 +72,8 @@ func (s *PVZRepositoryTestSuite) TestCreatePvz_DBError() {
 	}
 	dbErr := errors.New("database error")
 
-	s.mock.ExpectExec("INSERT INTO pvz \\(id, registration_date, city\\) VALUES \\(\\$1, \\$2, \\$3\\)").
-		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), pvz.City).
+	s.mock.ExpectQuery("INSERT INTO pvz \\(id, registration_date, city, status\\) VALUES \\(\\$1, \\$2, \\$3, \\$4\\) RETURNING").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), pvz.City, entity.PvzStatusActive).
 		WillReturnError(dbErr)
 
 	result, err := s.repo.CreatePvz(pvz)
@@ -71,3 +83,281 @@ func (s *PVZRepositoryTestSuite) TestCreatePvz_DBError() {
 	require.Nil(s.T(), result)
 	require.NoError(s.T(), s.mock.ExpectationsWereMet())
 }
+
+func (s *PVZRepositoryTestSuite) TestGetPvz_SortByProductCountDesc() {
+	pvzID := uuid.New()
+	receptionID := uuid.New()
+	productID := uuid.New()
+	now := time.Now()
+	page, limit := 1, 10
+
+	s.mock.ExpectQuery("ORDER BY\\s+product_count DESC, p.id.*ORDER BY\\s+fp.product_count DESC, fp.id").
+		WithArgs(nil, nil, &limit, 0, false).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "city", "registration_date", "address", "latitude", "longitude", "opening_hours", "phone", "status", "archived", "archived_at", "timezone", "version",
+			"id", "reception_datetime", "status", "pvz_id", "closed_at", "version",
+			"id", "acceptance_datetime", "product_type", "reception_id", "barcode",
+			"weight_grams", "length_cm", "width_cm", "height_cm",
+			"status", "stored_at", "issued_at", "returned_at", "shipment_id",
+		}).
+			AddRow(pvzID, "Москва", now, nil, nil, nil, nil, nil, "active", false, nil, "Europe/Moscow", 1, receptionID, now, "in_progress", pvzID, nil, 1, productID, now, "обувь", receptionID, nil, 500, 10, 20, 30, "accepted", nil, nil, nil, nil).
+			AddRow(pvzID, "Москва", now, nil, nil, nil, nil, nil, "active", false, nil, "Europe/Moscow", 1, receptionID, now, "in_progress", pvzID, nil, 1, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil))
+
+	result, err := s.repo.GetPvz(&request.GetPvz{
+		Page:  &page,
+		Limit: &limit,
+		Sort:  entity.PvzSortProductCount,
+		Order: entity.SortOrderDesc,
+	})
+
+	require.NoError(s.T(), err)
+	require.Len(s.T(), result, 1)
+	require.Len(s.T(), result[0].Receptions, 1)
+	require.Len(s.T(), result[0].Receptions[0].Products, 1)
+	require.Equal(s.T(), productID, result[0].Receptions[0].Products[0].Id)
+	require.Equal(s.T(), int64(500), result[0].Receptions[0].TotalWeightGrams)
+	require.Equal(s.T(), int64(6000), result[0].Receptions[0].TotalVolumeCm3)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *PVZRepositoryTestSuite) TestGetPvz_InvalidSort() {
+	result, err := s.repo.GetPvz(&request.GetPvz{
+		Sort:  "id; DROP TABLE pvz",
+		Order: entity.SortOrderAsc,
+	})
+
+	require.ErrorIs(s.T(), err, repository.ErrInvalidSort)
+	require.Nil(s.T(), result)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *PVZRepositoryTestSuite) TestGetPvz_SummaryView() {
+	pvzID := uuid.New()
+	now := time.Now()
+
+	s.mock.ExpectQuery("FROM\\s+pvz p\\s+LEFT JOIN\\s+reception r ON p.id = r.pvz_id AND .+"+
+		"SELECT\\s+fp.id, fp.city, fp.registration_date, .*fp.timezone, fp.version, fp.reception_count, fp.product_count\\s+FROM\\s+filtered_pvz fp").
+		WithArgs(nil, nil, nil, 0, false).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "city", "registration_date", "address", "latitude", "longitude", "opening_hours", "phone", "status", "archived", "archived_at", "timezone", "version",
+			"reception_count", "product_count",
+		}).
+			AddRow(pvzID, "Казань", now, "ул. Баумана, 1", 55.79, 49.12, "Пн-Вс 09:00-21:00", "+78432000000", "active", false, nil, "Europe/Moscow", 1, 2, 7))
+
+	result, err := s.repo.GetPvz(&request.GetPvz{
+		Sort:  entity.PvzSortRegistrationDate,
+		Order: entity.SortOrderAsc,
+		View:  entity.PvzViewSummary,
+	})
+
+	require.NoError(s.T(), err)
+	require.Len(s.T(), result, 1)
+	require.Equal(s.T(), pvzID, result[0].Pvz.Id)
+	require.Equal(s.T(), 2, *result[0].ReceptionCount)
+	require.Equal(s.T(), 7, *result[0].ProductCount)
+	require.Equal(s.T(), 55.79, *result[0].Pvz.Latitude)
+	require.Equal(s.T(), "active", result[0].Pvz.Status)
+	require.Nil(s.T(), result[0].Receptions)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *PVZRepositoryTestSuite) TestGetPvz_WithReceptionsView() {
+	pvzID := uuid.New()
+	now := time.Now()
+
+	s.mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM product pr WHERE pr.reception_id = r.id").
+		WithArgs(nil, nil, nil, 0, false).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "city", "registration_date", "address", "latitude", "longitude", "opening_hours", "phone", "status", "archived", "archived_at", "timezone", "version",
+			"id", "reception_datetime", "status", "pvz_id", "closed_at", "version", "count", "total_weight", "total_volume",
+		}).
+			AddRow(pvzID, "Казань", now.Add(-time.Hour), nil, nil, nil, nil, nil, "active", false, nil, "Europe/Moscow", 1, uuid.New(), now.Add(-time.Hour), "closed", pvzID, now, 1, 3, 2500, 18000).
+			AddRow(pvzID, "Казань", now, nil, nil, nil, nil, nil, "active", false, nil, "Europe/Moscow", 1, uuid.New(), now, "in_progress", pvzID, nil, 1, 0, 0, 0))
+
+	result, err := s.repo.GetPvz(&request.GetPvz{
+		Sort:  entity.PvzSortCity,
+		Order: entity.SortOrderAsc,
+		View:  entity.PvzViewWithReceptions,
+	})
+
+	require.NoError(s.T(), err)
+	require.Len(s.T(), result, 1)
+	require.Len(s.T(), result[0].Receptions, 2)
+	require.Equal(s.T(), 3, result[0].Receptions[0].ProductCount)
+	require.Equal(s.T(), int64(2500), result[0].Receptions[0].TotalWeightGrams)
+	require.Equal(s.T(), int64(18000), result[0].Receptions[0].TotalVolumeCm3)
+	require.Equal(s.T(), 3600.0, *result[0].Receptions[0].Reception.DurationSeconds)
+	require.Nil(s.T(), result[0].Receptions[0].Products)
+	require.Nil(s.T(), result[0].Receptions[1].Reception.ClosedAt)
+	require.Equal(s.T(), "Europe/Moscow", result[0].Receptions[0].Reception.Timezone)
+	_, offset := result[0].Receptions[0].Reception.DateTimeLocal.Zone()
+	require.Equal(s.T(), 3*60*60, offset)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *PVZRepositoryTestSuite) TestUpdatePvz_Success() {
+	pvzID := uuid.New()
+	now := time.Now()
+	status := entity.PvzStatusSuspended
+	update := entity.PvzUpdate{Status: &status}
+
+	s.mock.ExpectQuery("UPDATE pvz SET\\s+address = COALESCE\\(\\$2, address\\),.*version = version \\+ 1\\s+WHERE id = \\$1 AND NOT archived AND \\(\\$8::bigint\\[\\] IS NULL OR version = ANY\\(\\$8\\)\\)\\s+RETURNING").
+		WithArgs(pvzID, nil, nil, nil, nil, nil, &status, nil).
+		WillReturnRows(sqlmock.NewRows(pvzColumns).
+			AddRow(pvzID, "Москва", now, nil, nil, nil, nil, nil, status, false, nil, "Europe/Moscow", nil, nil, nil, "reject", 2))
+
+	result, err := s.repo.UpdatePvz(pvzID, update, nil)
+
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), pvzID, result.Id)
+	require.Equal(s.T(), entity.PvzStatusSuspended, result.Status)
+	require.Equal(s.T(), int64(2), result.Version)
+	require.Nil(s.T(), result.Address)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *PVZRepositoryTestSuite) TestUpdatePvz_NotFound() {
+	pvzID := uuid.New()
+
+	s.mock.ExpectQuery("UPDATE pvz SET").
+		WillReturnError(sql.ErrNoRows)
+
+	result, err := s.repo.UpdatePvz(pvzID, entity.PvzUpdate{}, nil)
+
+	require.ErrorIs(s.T(), err, repository.ErrPvzNotFound)
+	require.Nil(s.T(), result)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *PVZRepositoryTestSuite) TestUpdatePvz_VersionMismatch() {
+	pvzID := uuid.New()
+	versions := []int64{3}
+
+	s.mock.ExpectQuery("UPDATE pvz SET").
+		WithArgs(pvzID, nil, nil, nil, nil, nil, nil, pq.Array(versions)).
+		WillReturnError(sql.ErrNoRows)
+	s.mock.ExpectQuery("SELECT EXISTS \\(SELECT 1 FROM pvz WHERE id = \\$1 AND NOT archived\\)").
+		WithArgs(pvzID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	result, err := s.repo.UpdatePvz(pvzID, entity.PvzUpdate{}, versions)
+
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), result)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *PVZRepositoryTestSuite) TestGetNearbyPvz_Success() {
+	nearID, farID := uuid.New(), uuid.New()
+	now := time.Now()
+	req := &request.GetNearbyPvz{Latitude: 55.75, Longitude: 37.62, Radius: 5000, Limit: 20}
+
+	s.mock.ExpectQuery("earth_box\\(ll_to_earth\\(\\$1, \\$2\\), \\$3\\).*ORDER BY\\s+distance, p.id\\s+LIMIT \\$4").
+		WithArgs(req.Latitude, req.Longitude, req.Radius, req.Limit).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "city", "registration_date", "address", "latitude", "longitude", "opening_hours", "phone", "status", "archived", "archived_at", "timezone", "version",
+			"distance",
+		}).
+			AddRow(nearID, "Москва", now, "Тверская, 1", 55.76, 37.61, nil, nil, "active", false, nil, "Europe/Moscow", 1, 1302.5).
+			AddRow(farID, "Москва", now, "Арбат, 10", 55.75, 37.59, nil, nil, "active", false, nil, "Europe/Moscow", 1, 1880.1))
+
+	result, err := s.repo.GetNearbyPvz(req)
+
+	require.NoError(s.T(), err)
+	require.Len(s.T(), result, 2)
+	require.Equal(s.T(), nearID, result[0].Pvz.Id)
+	require.Equal(s.T(), 1302.5, result[0].Distance)
+	require.Equal(s.T(), "Арбат, 10", *result[1].Pvz.Address)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *PVZRepositoryTestSuite) TestGetNearbyPvz_Empty() {
+	s.mock.ExpectQuery("earth_distance").
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "city", "registration_date", "address", "latitude", "longitude", "opening_hours", "phone", "status", "archived", "archived_at", "timezone", "version",
+			"distance",
+		}))
+
+	result, err := s.repo.GetNearbyPvz(&request.GetNearbyPvz{Radius: 100, Limit: 20})
+
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), result)
+	require.Empty(s.T(), result)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *PVZRepositoryTestSuite) TestGetPvz_IncludeArchived() {
+	s.mock.ExpectQuery("\\(\\$5 OR NOT p.archived\\)").
+		WithArgs(nil, nil, nil, 0, true).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "city", "registration_date", "address", "latitude", "longitude", "opening_hours", "phone", "status", "archived", "archived_at", "timezone", "version",
+			"reception_count", "product_count",
+		}).
+			AddRow(uuid.New(), "Казань", time.Now(), nil, nil, nil, nil, nil, "active", true, time.Now(), "Europe/Moscow", 1, 1, 0))
+
+	result, err := s.repo.GetPvz(&request.GetPvz{
+		Sort:            entity.PvzSortRegistrationDate,
+		Order:           entity.SortOrderAsc,
+		View:            entity.PvzViewSummary,
+		IncludeArchived: true,
+	})
+
+	require.NoError(s.T(), err)
+	require.Len(s.T(), result, 1)
+	require.True(s.T(), result[0].Pvz.Archived)
+	require.NotNil(s.T(), result[0].Pvz.ArchivedAt)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *PVZRepositoryTestSuite) TestArchivePvz_Success() {
+	pvzID := uuid.New()
+	now := time.Now()
+
+	s.mock.ExpectExec("UPDATE pvz SET archived = true, archived_at = \\$2, version = version \\+ 1\\s+WHERE id = \\$1 AND NOT archived.+"+
+		"AND NOT EXISTS \\(SELECT 1 FROM reception r WHERE r.pvz_id = pvz.id AND r.status = 'in_progress'\\)").
+		WithArgs(pvzID, now, nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	archived, err := s.repo.ArchivePvz(pvzID, now, nil)
+
+	require.NoError(s.T(), err)
+	require.True(s.T(), archived)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *PVZRepositoryTestSuite) TestArchivePvz_NotFound() {
+	pvzID := uuid.New()
+	now := time.Now()
+
+	s.mock.ExpectExec("UPDATE pvz SET archived = true").
+		WithArgs(pvzID, now, nil).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(pvzID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	archived, err := s.repo.ArchivePvz(pvzID, now, nil)
+
+	require.ErrorIs(s.T(), err, repository.ErrPvzNotFound)
+	require.False(s.T(), archived)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *PVZRepositoryTestSuite) TestArchivePvz_VersionMismatch() {
+	pvzID := uuid.New()
+	now := time.Now()
+	versions := []int64{1}
+
+	s.mock.ExpectExec("UPDATE pvz SET archived = true").
+		WithArgs(pvzID, now, pq.Array(versions)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(pvzID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	archived, err := s.repo.ArchivePvz(pvzID, now, versions)
+
+	require.NoError(s.T(), err)
+	require.False(s.T(), archived)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
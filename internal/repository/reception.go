@@ -3,14 +3,162 @@ package repository
 import (
 	"database/sql"
 	"errors"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/alexey-shedrin/avito-test-task/internal/database"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
-var errProductNotFound = errors.New("product not found")
+var (
+	ErrReceptionNotFound   = apperror.NotFound("reception_not_found", "reception not found")
+	ErrReceptionNotOpened  = apperror.Conflict("reception_not_opened", "reception is not opened")
+	ErrProductNotFound     = apperror.NotFound("product_not_found", "product not found")
+	ErrProductLimitReached = apperror.Conflict("product_limit_reached", "product limit for reception is reached")
+	ErrDuplicateScan       = apperror.Conflict("duplicate_scan", "parcel is already scanned in this reception")
+)
+
+const receptionColumns = `r.id, r.pvz_id, r.status, r.reception_datetime, r.closed_at,
+            (SELECT COUNT(*) FROM product pr WHERE pr.reception_id = r.id),
+            r.cancel_reason, r.cancelled_at, r.auto_closed,
+            (SELECT c.timezone FROM pvz p JOIN city c ON c.name = p.city WHERE p.id = r.pvz_id),
+            (SELECT COALESCE(SUM(pr.weight_grams), 0) FROM product pr WHERE pr.reception_id = r.id),
+            (SELECT COALESCE(SUM(` + productVolume + `), 0) FROM product pr WHERE pr.reception_id = r.id),
+            r.version`
+
+// productVolume объем товара pr в кубических сантиметрах. NULL для товара без габаритов.
+const productVolume = `pr.length_cm::bigint * pr.width_cm * pr.height_cm`
+
+// productColumns поля товара pr в порядке scanProduct.
+const productColumns = `pr.id, pr.product_type, pr.acceptance_datetime, pr.reception_id, pr.barcode,
+            pr.weight_grams, pr.length_cm, pr.width_cm, pr.height_cm,
+            pr.status, pr.stored_at, pr.issued_at, pr.returned_at, pr.shipment_id`
+
+// productTimezone часовой пояс ПВЗ товара pr.
+const productTimezone = `(
+            SELECT c.timezone FROM reception r JOIN pvz p ON p.id = r.pvz_id JOIN city c ON c.name = p.city
+            WHERE r.id = pr.reception_id)`
+
+// scanReception читает строку, выбранную по receptionColumns.
+func scanReception(row *sql.Row) (*entity.Reception, error) {
+	var reception entity.Reception
+	var productCount int
+	var totalWeight, totalVolume int64
+
+	err := row.Scan(
+		&reception.Id, &reception.PvzId, &reception.Status, &reception.DateTime, &reception.ClosedAt,
+		&productCount, &reception.CancelReason, &reception.CancelledAt, &reception.AutoClosed, &reception.Timezone,
+		&totalWeight, &totalVolume, &reception.Version,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrReceptionNotFound
+		}
+
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	reception.ProductCount = &productCount
+	reception.TotalWeightGrams = &totalWeight
+	reception.TotalVolumeCm3 = &totalVolume
+
+	return &reception, nil
+}
+
+// scanUpdatedReception читает приемку, измененную UPDATE с условием на версию.
+// Приемки не удаляются, поэтому отсутствие строки означает, что версия уже изменилась: возвращает nil.
+func scanUpdatedReception(row *sql.Row) (*entity.Reception, error) {
+	reception, err := scanReception(row)
+	if errors.Is(err, ErrReceptionNotFound) {
+		return nil, nil
+	}
+
+	return reception, err
+}
+
+// productDest возвращает приемники для полей productColumns. Габариты применяются
+// к товару через setDimensions после Scan.
+func productDest(product *entity.Product, dims *[3]sql.NullInt64) []any {
+	return []any{
+		&product.Id, &product.Type, &product.DateTime, &product.ReceptionId, &product.Barcode,
+		&product.WeightGrams, &dims[0], &dims[1], &dims[2],
+		&product.Status, &product.StoredAt, &product.IssuedAt, &product.ReturnedAt, &product.ShipmentId,
+	}
+}
+
+// scanProduct читает строку, выбранную по productColumns и productTimezone.
+func scanProduct(row *sql.Row) (*entity.Product, error) {
+	var product entity.Product
+	var dims [3]sql.NullInt64
+
+	if err := row.Scan(append(productDest(&product, &dims), &product.Timezone)...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrProductNotFound
+		}
+
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+	setDimensions(&product, dims)
+
+	return &product, nil
+}
+
+// scanProducts читает строки, выбранные по productColumns и productTimezone.
+func scanProducts(rows *sql.Rows) ([]*entity.Product, error) {
+	products := make([]*entity.Product, 0)
+	for rows.Next() {
+		var product entity.Product
+		var dims [3]sql.NullInt64
+		if err := rows.Scan(append(productDest(&product, &dims), &product.Timezone)...); err != nil {
+			log.Printf("error: %v", err)
+
+			return nil, err
+		}
+		setDimensions(&product, dims)
+
+		products = append(products, &product)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	return products, nil
+}
+
+func setDimensions(product *entity.Product, dims [3]sql.NullInt64) {
+	if !dims[0].Valid {
+		product.Dimensions = nil
+
+		return
+	}
+
+	product.Dimensions = &entity.ProductDimensions{
+		LengthCm: int(dims[0].Int64),
+		WidthCm:  int(dims[1].Int64),
+		HeightCm: int(dims[2].Int64),
+	}
+}
+
+// productDimensionArgs раскладывает габариты товара на аргументы запроса.
+func productDimensionArgs(product *entity.Product) (length, width, height *int) {
+	if product.Dimensions == nil {
+		return nil, nil, nil
+	}
+
+	return &product.Dimensions.LengthCm, &product.Dimensions.WidthCm, &product.Dimensions.HeightCm
+}
 
 type ReceptionRepository struct {
 	db *sql.DB
@@ -21,6 +169,28 @@ func NewReceptionRepository(db *sql.DB) *ReceptionRepository {
 		db: db,
 	}
 }
+
+func (r *ReceptionRepository) GetPvzStatus(pvzID uuid.UUID) (string, bool, error) {
+	log.SetPrefix("repository.GetPvzStatus")
+	query := `SELECT status, archived FROM pvz WHERE id = $1`
+
+	var (
+		status   string
+		archived bool
+	)
+	if err := r.db.QueryRow(query, pvzID).Scan(&status, &archived); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", false, ErrPvzNotFound
+		}
+
+		log.Printf("error: %v", err)
+
+		return "", false, err
+	}
+
+	return status, archived, nil
+}
+
 func (r *ReceptionRepository) GetOpenedReceptionId(pvzID uuid.UUID) (uuid.UUID, error) {
 	log.SetPrefix("repository.CheckOpenedReception")
 	query := `SELECT id FROM reception WHERE pvz_id = $1 AND status = 'in_progress'`
@@ -41,64 +211,629 @@ func (r *ReceptionRepository) GetOpenedReceptionId(pvzID uuid.UUID) (uuid.UUID,
 
 func (r *ReceptionRepository) CreateReception(reception *entity.Reception) (*entity.Reception, error) {
 	log.SetPrefix("repository.CreateReception")
-	query := `INSERT INTO reception (id, reception_datetime, pvz_id, status) VALUES ($1, $2, $3, $4)`
+	query := `INSERT INTO reception AS r (id, reception_datetime, opened_at, pvz_id, status) VALUES ($1, $2, $2, $3, $4) RETURNING ` + receptionColumns
 
-	reception.DateTime = time.Now()
-	reception.Status = "in_progress"
-	reception.Id = uuid.New()
+	return scanReception(r.db.QueryRow(query, uuid.New(), time.Now().UTC(), reception.PvzId, entity.ReceptionStatusInProgress))
+}
 
-	if _, err := r.db.Exec(query, reception.Id, reception.DateTime, reception.PvzId, reception.Status); err != nil {
+// CreateProduct добавляет товар в приемку. Лимит товаров проверяется под блокировкой
+// приемки, поэтому параллельные запросы его не превысят: ErrProductLimitReached. Повторный
+// штрихкод в приемке, отсканированный параллельно, дает ErrDuplicateScan.
+func (r *ReceptionRepository) CreateProduct(product *entity.Product) (*entity.Product, error) {
+	log.SetPrefix("repository.CreateProduct")
+	query := `
+        INSERT INTO product AS pr (
+            id, product_type, acceptance_datetime, reception_id, barcode, weight_grams, length_cm, width_cm, height_cm
+        )
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+        RETURNING ` + productTimezone
+
+	tx, err := r.db.Begin()
+	if err != nil {
 		log.Printf("error: %v", err)
 
 		return nil, err
 	}
+	defer tx.Rollback()
 
-	return reception, nil
-}
-
-func (r *ReceptionRepository) CreateProduct(product *entity.Product) (*entity.Product, error) {
-	log.SetPrefix("repository.CreateProduct")
-	query := `INSERT INTO product (id, product_type, acceptance_datetime, reception_id) VALUES ($1, $2, $3, $4)`
+	if err = reserveProductCapacity(tx, product.ReceptionId, 1); err != nil {
+		return nil, err
+	}
 
-	product.DateTime = time.Now()
+	product.DateTime = time.Now().UTC()
 	product.Id = uuid.New()
+	length, width, height := productDimensionArgs(product)
 
-	if _, err := r.db.Exec(query, product.Id, product.Type, product.DateTime, product.ReceptionId); err != nil {
+	err = tx.QueryRow(
+		query,
+		product.Id, product.Type, product.DateTime, product.ReceptionId, product.Barcode,
+		product.WeightGrams, length, width, height,
+	).Scan(&product.Timezone)
+	if err != nil {
+		if database.IsUniqueViolation(err) {
+			return nil, ErrDuplicateScan
+		}
+
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
 		log.Printf("error: %v", err)
 
 		return nil, err
 	}
 
+	product.Status = entity.ProductStatusAccepted
+
 	return product, nil
 }
 
-func (r *ReceptionRepository) DeleteLastProduct(receptionID uuid.UUID) error {
-	log.SetPrefix("repository.DeleteLastProduct")
-	query := `DELETE FROM product WHERE id = (SELECT id FROM product WHERE reception_id = $1 ORDER BY acceptance_datetime DESC LIMIT 1)`
-	if _, err := r.db.Exec(query, receptionID); err != nil {
+// reserveProductCapacity блокирует приемку до конца транзакции tx и проверяет, что она еще
+// открыта и в нее можно добавить еще count товаров. Статус и остаток читаются уже после
+// блокировки, поэтому видят параллельное закрытие приемки и добавленные товары.
+func reserveProductCapacity(tx *sql.Tx, receptionID uuid.UUID, count int) error {
+	if err := lockOpenedReception(tx, receptionID); err != nil {
+		return err
+	}
+
+	capacity, err := remainingProductCapacity(tx, receptionID)
+	if err != nil {
+		return err
+	}
+
+	if capacity != nil && *capacity < count {
+		return ErrProductLimitReached
+	}
+
+	return nil
+}
+
+// lockOpenedReception блокирует приемку до конца транзакции tx. Товары добавляются только
+// под этой блокировкой, поэтому после нее остаток лимита и отсканированные штрихкоды не меняются.
+func lockOpenedReception(tx *sql.Tx, receptionID uuid.UUID) error {
+	var status string
+	if err := tx.QueryRow(`SELECT status FROM reception WHERE id = $1 FOR UPDATE`, receptionID).Scan(&status); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return errProductNotFound
+			return ErrReceptionNotFound
 		}
 
 		log.Printf("error: %v", err)
 
 		return err
 	}
+
+	if status != entity.ReceptionStatusInProgress {
+		return ErrReceptionNotOpened
+	}
+
 	return nil
 }
 
-func (r *ReceptionRepository) CloseLastReception(receptionID uuid.UUID) (*entity.Reception, error) {
-	log.SetPrefix("repository.CloseLastReception")
-	query := `UPDATE reception SET status = 'closed' WHERE id = $1`
-	if _, err := r.db.Exec(query, receptionID); err != nil {
+// GetRemainingProductCapacity возвращает, сколько товаров еще можно добавить в приемку.
+// nil означает, что для ПВЗ лимит не задан.
+func (r *ReceptionRepository) GetRemainingProductCapacity(receptionID uuid.UUID) (*int, error) {
+	log.SetPrefix("repository.GetRemainingProductCapacity")
+
+	return remainingProductCapacity(r.db, receptionID)
+}
+
+func remainingProductCapacity(q interface {
+	QueryRow(query string, args ...any) *sql.Row
+}, receptionID uuid.UUID) (*int, error) {
+	query := `
+        SELECT 
+            p.max_products_per_reception - (SELECT COUNT(*) FROM product pr WHERE pr.reception_id = r.id)
+        FROM 
+            reception r
+        JOIN 
+            pvz p ON p.id = r.pvz_id
+        WHERE 
+            r.id = $1`
+
+	var capacity *int
+	if err := q.QueryRow(query, receptionID).Scan(&capacity); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrReceptionNotFound
+		}
+
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	return capacity, nil
+}
+
+// GetStorageUsage возвращает объем, занятый товарами ПВЗ приемки, и лимит объема ПВЗ.
+// Товары отмененных приемок, выданные и возвращенные отправителю товары место не занимают.
+func (r *ReceptionRepository) GetStorageUsage(receptionID uuid.UUID) (*entity.StorageUsage, error) {
+	log.SetPrefix("repository.GetStorageUsage")
+	query := `
+        SELECT 
+            (
+                SELECT COALESCE(SUM(` + productVolume + `), 0)
+                FROM product pr
+                JOIN reception rr ON rr.id = pr.reception_id
+                WHERE rr.pvz_id = p.id AND rr.status <> 'cancelled' AND pr.status IN ('accepted', 'stored')
+            ),
+            p.max_storage_volume_cm3,
+            p.storage_limit_mode
+        FROM 
+            reception r
+        JOIN 
+            pvz p ON p.id = r.pvz_id
+        WHERE 
+            r.id = $1`
+
+	var usage entity.StorageUsage
+	if err := r.db.QueryRow(query, receptionID).Scan(&usage.UsedVolumeCm3, &usage.MaxVolumeCm3, &usage.LimitMode); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrReceptionNotFound
+		}
+
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	return &usage, nil
+}
+
+// CreateProducts добавляет товары одной приемки одним многострочным INSERT. Лимит товаров
+// и повторные штрихкоды проверяются под блокировкой приемки, поэтому учитывают товары,
+// добавленные параллельно. В режиме partial не прошедшие проверку товары пропускаются, а их
+// ошибки возвращаются по индексу товара; иначе первый такой товар отклоняет весь пакет.
+func (r *ReceptionRepository) CreateProducts(products []*entity.Product, partial bool) ([]error, error) {
+	log.SetPrefix("repository.CreateProducts")
+
+	rejected := make([]error, len(products))
+	if len(products) == 0 {
+		return rejected, nil
+	}
+
+	receptionID := products[0].ReceptionId
+
+	tx, err := r.db.Begin()
+	if err != nil {
 		log.Printf("error: %v", err)
 
 		return nil, err
 	}
+	defer tx.Rollback()
+
+	if err = lockOpenedReception(tx, receptionID); err != nil {
+		return nil, err
+	}
+
+	capacity, err := remainingProductCapacity(tx, receptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	barcodes := make([]string, 0, len(products))
+	for _, product := range products {
+		if product.Barcode != nil {
+			barcodes = append(barcodes, *product.Barcode)
+		}
+	}
+
+	scanned := make(map[string]bool, len(barcodes))
+	if len(barcodes) > 0 {
+		found, err := scannedBarcodes(tx, receptionID, barcodes)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, barcode := range found {
+			scanned[barcode] = true
+		}
+	}
+
+	accepted := make([]*entity.Product, 0, len(products))
+	for i, product := range products {
+		switch {
+		case product.Barcode != nil && scanned[*product.Barcode]:
+			rejected[i] = ErrDuplicateScan
+		case capacity != nil && len(accepted) >= *capacity:
+			rejected[i] = ErrProductLimitReached
+		default:
+			accepted = append(accepted, product)
+			continue
+		}
+
+		if !partial {
+			return nil, rejected[i]
+		}
+	}
+
+	if err = insertProducts(tx, accepted); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	return rejected, nil
+}
+
+// insertProducts добавляет products одним многострочным INSERT в транзакции tx.
+func insertProducts(tx *sql.Tx, products []*entity.Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	var query strings.Builder
+	query.WriteString(`INSERT INTO product AS pr (
+            id, product_type, acceptance_datetime, reception_id, barcode, weight_grams, length_cm, width_cm, height_cm
+        ) VALUES `)
+
+	const columns = 9
+
+	now := time.Now().UTC()
+	args := make([]any, 0, len(products)*columns)
+	for i, product := range products {
+		product.Id = uuid.New()
+		// Разносим время на микросекунду, чтобы сохранить порядок для DeleteLastProduct.
+		product.DateTime = now.Add(time.Duration(i) * time.Microsecond)
+
+		if i > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString("(")
+		for j := 1; j <= columns; j++ {
+			if j > 1 {
+				query.WriteString(", ")
+			}
+			fmt.Fprintf(&query, "$%d", i*columns+j)
+		}
+		query.WriteString(")")
+
+		length, width, height := productDimensionArgs(product)
+		args = append(args,
+			product.Id, product.Type, product.DateTime, product.ReceptionId, product.Barcode,
+			product.WeightGrams, length, width, height,
+		)
+	}
+	query.WriteString(` RETURNING pr.id, ` + productTimezone)
+
+	rows, err := tx.Query(query.String(), args...)
+	if err != nil {
+		if database.IsUniqueViolation(err) {
+			return ErrDuplicateScan
+		}
+
+		log.Printf("error: %v", err)
+
+		return err
+	}
+	defer rows.Close()
+
+	timezones := make(map[uuid.UUID]string, len(products))
+	for rows.Next() {
+		var id uuid.UUID
+		var timezone string
+		if err = rows.Scan(&id, &timezone); err != nil {
+			log.Printf("error: %v", err)
+
+			return err
+		}
+
+		timezones[id] = timezone
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("error: %v", err)
+
+		return err
+	}
+
+	for _, product := range products {
+		product.Timezone = timezones[product.Id]
+		product.Status = entity.ProductStatusAccepted
+	}
+
+	return nil
+}
+
+func (r *ReceptionRepository) GetActiveProductTypes() ([]string, error) {
+	log.SetPrefix("repository.GetActiveProductTypes")
+	query := `SELECT name FROM product_type WHERE NOT deprecated`
+
+	rows, err := r.db.Query(query)
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+	defer rows.Close()
+
+	names := make([]string, 0)
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			log.Printf("error: %v", err)
+
+			return nil, err
+		}
+
+		names = append(names, name)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	return names, nil
+}
+
+// FindScannedBarcodes возвращает те из barcodes, что уже отсканированы в приемке.
+func (r *ReceptionRepository) FindScannedBarcodes(receptionID uuid.UUID, barcodes []string) ([]string, error) {
+	log.SetPrefix("repository.FindScannedBarcodes")
+
+	return scannedBarcodes(r.db, receptionID, barcodes)
+}
+
+func scannedBarcodes(q interface {
+	Query(query string, args ...any) (*sql.Rows, error)
+}, receptionID uuid.UUID, barcodes []string) ([]string, error) {
+	query := `SELECT barcode FROM product WHERE reception_id = $1 AND barcode = ANY($2)`
+
+	rows, err := q.Query(query, receptionID, pq.Array(barcodes))
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+	defer rows.Close()
+
+	scanned := make([]string, 0)
+	for rows.Next() {
+		var barcode string
+		if err = rows.Scan(&barcode); err != nil {
+			log.Printf("error: %v", err)
+
+			return nil, err
+		}
+
+		scanned = append(scanned, barcode)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	return scanned, nil
+}
+
+func (r *ReceptionRepository) GetProductsByBarcode(barcode string) ([]*entity.Product, error) {
+	log.SetPrefix("repository.GetProductsByBarcode")
+	query := `
+        SELECT ` + productColumns + `, ` + productTimezone + `
+        FROM product pr
+        WHERE pr.barcode = $1
+        ORDER BY pr.acceptance_datetime DESC`
+
+	rows, err := r.db.Query(query, barcode)
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanProducts(rows)
+}
+
+func (r *ReceptionRepository) GetProduct(productID uuid.UUID) (*entity.Product, error) {
+	log.SetPrefix("repository.GetProduct")
+	query := `SELECT ` + productColumns + `, ` + productTimezone + ` FROM product pr WHERE pr.id = $1`
+
+	return scanProduct(r.db.QueryRow(query, productID))
+}
+
+// UpdateProductStatus переводит товар из статуса from в статус to и проставляет время перехода.
+// Если статус товара уже изменился, возвращает nil.
+func (r *ReceptionRepository) UpdateProductStatus(productID uuid.UUID, from, to string, at time.Time) (*entity.Product, error) {
+	log.SetPrefix("repository.UpdateProductStatus")
+	query := `
+        UPDATE product pr SET
+            status = $3,
+            stored_at = CASE WHEN $3 = 'stored' THEN $4 ELSE pr.stored_at END,
+            issued_at = CASE WHEN $3 = 'issued' THEN $4 ELSE pr.issued_at END,
+            returned_at = CASE WHEN $3 = 'returned_to_sender' THEN $4 ELSE pr.returned_at END
+        WHERE pr.id = $1 AND pr.status = $2
+        RETURNING ` + productColumns + `, ` + productTimezone
+
+	product, err := scanProduct(r.db.QueryRow(query, productID, from, to, at))
+	if !errors.Is(err, ErrProductNotFound) {
+		return product, err
+	}
+
+	exists, err := r.productExists(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, ErrProductNotFound
+	}
+
+	return nil, nil
+}
+
+func (r *ReceptionRepository) productExists(productID uuid.UUID) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM product WHERE id = $1)`
+
+	var exists bool
+	if err := r.db.QueryRow(query, productID).Scan(&exists); err != nil {
+		log.Printf("error: %v", err)
+
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// GetProductsOnHand возвращает товары, которые находятся в ПВЗ: принятые и переданные на хранение.
+// Товары отмененных приемок не учитываются. statuses сужает выборку, пустой список означает оба статуса.
+func (r *ReceptionRepository) GetProductsOnHand(pvzID uuid.UUID, statuses []string) ([]*entity.Product, error) {
+	log.SetPrefix("repository.GetProductsOnHand")
+	query := `
+        SELECT ` + productColumns + `, ` + productTimezone + `
+        FROM product pr
+        JOIN reception r ON r.id = pr.reception_id
+        WHERE 
+            r.pvz_id = $1 AND
+            r.status <> 'cancelled' AND
+            pr.status IN ('accepted', 'stored') AND
+            (cardinality($2::text[]) = 0 OR pr.status = ANY($2))
+        ORDER BY pr.acceptance_datetime`
+
+	if statuses == nil {
+		statuses = []string{}
+	}
+
+	rows, err := r.db.Query(query, pvzID, pq.Array(statuses))
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanProducts(rows)
+}
+
+// DeleteLastProduct удаляет последний добавленный товар приемки. false означает, что товаров нет.
+// Товары, переданные на хранение после повторного открытия приемки, не удаляются.
+func (r *ReceptionRepository) DeleteLastProduct(receptionID uuid.UUID) (bool, error) {
+	log.SetPrefix("repository.DeleteLastProduct")
+	query := `
+        DELETE FROM product WHERE id = (
+            SELECT id FROM product WHERE reception_id = $1 AND status = 'accepted' ORDER BY acceptance_datetime DESC LIMIT 1
+        )`
+
+	return r.deleteProducts(query, receptionID)
+}
+
+// DeleteProduct удаляет товар приемки по id. false означает, что такого товара в приемке нет.
+func (r *ReceptionRepository) DeleteProduct(receptionID, productID uuid.UUID) (bool, error) {
+	log.SetPrefix("repository.DeleteProduct")
+	query := `DELETE FROM product WHERE reception_id = $1 AND id = $2 AND status = 'accepted'`
+
+	return r.deleteProducts(query, receptionID, productID)
+}
+
+func (r *ReceptionRepository) deleteProducts(query string, args ...any) (bool, error) {
+	res, err := r.db.Exec(query, args...)
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// CloseLastReception закрывает приемку версии version. Возвращает nil, если версия приемки изменилась.
+func (r *ReceptionRepository) CloseLastReception(receptionID uuid.UUID, version int64) (*entity.Reception, error) {
+	log.SetPrefix("repository.CloseLastReception")
+	query := `
+        UPDATE reception r SET status = $2, closed_at = $3, version = r.version + 1
+        WHERE r.id = $1 AND r.version = $4
+        RETURNING ` + receptionColumns
+
+	return scanUpdatedReception(r.db.QueryRow(query, receptionID, entity.ReceptionStatusClosed, time.Now().UTC(), version))
+}
+
+func (r *ReceptionRepository) GetReception(receptionID uuid.UUID) (*entity.Reception, error) {
+	log.SetPrefix("repository.GetReception")
+	query := `SELECT ` + receptionColumns + ` FROM reception r WHERE r.id = $1`
+
+	return scanReception(r.db.QueryRow(query, receptionID))
+}
+
+func (r *ReceptionRepository) HasNewerReception(pvzID uuid.UUID, after time.Time) (bool, error) {
+	log.SetPrefix("repository.HasNewerReception")
+	query := `SELECT EXISTS (SELECT 1 FROM reception WHERE pvz_id = $1 AND reception_datetime > $2)`
+
+	var exists bool
+	if err := r.db.QueryRow(query, pvzID, after).Scan(&exists); err != nil {
+		log.Printf("error: %v", err)
+
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// ReopenReception открывает приемку версии version, закрытую не раньше closedAfter, если в ПВЗ
+// нет открытой или более новой приемки. Время открытия сбрасывается, чтобы автозакрытие
+// отсчитывало max_open_minutes заново. Возвращает nil, если хотя бы одно условие не выполнено.
+func (r *ReceptionRepository) ReopenReception(receptionID uuid.UUID, version int64, closedAfter time.Time) (*entity.Reception, error) {
+	log.SetPrefix("repository.ReopenReception")
+	query := `
+        UPDATE reception r SET status = $2, closed_at = NULL, auto_closed = false, opened_at = $4, version = r.version + 1
+        WHERE r.id = $1 AND r.version = $3 AND r.status = 'closed' AND r.closed_at >= $5
+            AND NOT EXISTS (
+                SELECT 1 FROM reception o
+                WHERE o.pvz_id = r.pvz_id AND o.id <> r.id
+                    AND (o.status = 'in_progress' OR o.reception_datetime > r.reception_datetime)
+            )
+        RETURNING ` + receptionColumns
+
+	return scanUpdatedReception(r.db.QueryRow(
+		query, receptionID, entity.ReceptionStatusInProgress, version, time.Now().UTC(), closedAfter,
+	))
+}
+
+// CancelReception отменяет приемку версии version. Возвращает nil, если версия приемки изменилась.
+func (r *ReceptionRepository) CancelReception(receptionID uuid.UUID, reason string, version int64) (*entity.Reception, error) {
+	log.SetPrefix("repository.CancelReception")
+	query := `
+        UPDATE reception r SET status = $2, cancel_reason = $3, cancelled_at = $4, version = r.version + 1
+        WHERE r.id = $1 AND r.version = $5
+        RETURNING ` + receptionColumns
+
+	return scanUpdatedReception(r.db.QueryRow(query, receptionID, entity.ReceptionStatusCancelled, reason, time.Now().UTC(), version))
+}
+
+// CloseStaleReceptions автоматически закрывает приемки, открытые дольше max_open_minutes своего ПВЗ.
+// Для переоткрытой приемки время считается от последнего открытия.
+func (r *ReceptionRepository) CloseStaleReceptions(now time.Time) (int64, error) {
+	log.SetPrefix("repository.CloseStaleReceptions")
+	query := `
+        UPDATE reception r
+        SET status = $2, closed_at = $1, auto_closed = true, version = r.version + 1
+        FROM pvz p
+        WHERE 
+            p.id = r.pvz_id AND
+            r.status = $3 AND
+            p.max_open_minutes IS NOT NULL AND
+            r.opened_at + make_interval(mins => p.max_open_minutes) <= $1`
+
+	res, err := r.db.Exec(query, now, entity.ReceptionStatusClosed, entity.ReceptionStatusInProgress)
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return 0, err
+	}
 
-	return &entity.Reception{
-		Id:       receptionID,
-		Status:   "closed",
-		DateTime: time.Now(),
-	}, nil
+	return res.RowsAffected()
 }
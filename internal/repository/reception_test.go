@@ -4,15 +4,28 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
 	"github.com/alexey-shedrin/avito-test-task/internal/repository"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
+var receptionColumns = []string{
+	"id", "pvz_id", "status", "reception_datetime", "closed_at", "count", "cancel_reason", "cancelled_at", "auto_closed", "timezone",
+	"total_weight", "total_volume", "version",
+}
+
+var productColumns = []string{
+	"id", "product_type", "acceptance_datetime", "reception_id", "barcode",
+	"weight_grams", "length_cm", "width_cm", "height_cm",
+	"status", "stored_at", "issued_at", "returned_at", "shipment_id", "timezone",
+}
+
 type ReceptionRepositoryTestSuite struct {
 	suite.Suite
 	db   *sql.DB
@@ -35,6 +48,46 @@ func TestReceptionRepositorySuite(t *testing.T) {
 	suite.Run(t, new(ReceptionRepositoryTestSuite))
 }
 
+// expectCapacityLock ожидает блокировку приемки и подсчет остатка лимита товаров.
+func (s *ReceptionRepositoryTestSuite) expectCapacityLock(receptionID uuid.UUID, capacity any) {
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery("SELECT status FROM reception WHERE id = \\$1 FOR UPDATE").
+		WithArgs(receptionID).
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow(entity.ReceptionStatusInProgress))
+	s.mock.ExpectQuery("p.max_products_per_reception - \\(SELECT COUNT").
+		WithArgs(receptionID).
+		WillReturnRows(sqlmock.NewRows([]string{"capacity"}).AddRow(capacity))
+}
+
+func (s *ReceptionRepositoryTestSuite) TestGetPvzStatus_Success() {
+	pvzID := uuid.New()
+
+	s.mock.ExpectQuery("SELECT status, archived FROM pvz WHERE id = \\$1").
+		WithArgs(pvzID).
+		WillReturnRows(sqlmock.NewRows([]string{"status", "archived"}).AddRow(entity.PvzStatusClosed, true))
+
+	status, archived, err := s.repo.GetPvzStatus(pvzID)
+
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), entity.PvzStatusClosed, status)
+	require.True(s.T(), archived)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestGetPvzStatus_NotFound() {
+	pvzID := uuid.New()
+
+	s.mock.ExpectQuery("SELECT status, archived FROM pvz WHERE id = \\$1").
+		WithArgs(pvzID).
+		WillReturnError(sql.ErrNoRows)
+
+	status, _, err := s.repo.GetPvzStatus(pvzID)
+
+	require.ErrorIs(s.T(), err, repository.ErrPvzNotFound)
+	require.Empty(s.T(), status)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
 func (s *ReceptionRepositoryTestSuite) TestGetOpenedReceptionId_Success() {
 	pvzID := uuid.New()
 	expectedID := uuid.New()
@@ -85,16 +138,20 @@ func (s *ReceptionRepositoryTestSuite) TestCreateReception_Success() {
 		PvzId: uuid.New(),
 	}
 
-	s.mock.ExpectExec("INSERT INTO reception \\(id, reception_datetime, pvz_id, status\\) VALUES \\(\\$1, \\$2, \\$3, \\$4\\)").
+	receptionID := uuid.New()
+
+	s.mock.ExpectQuery("INSERT INTO reception AS r \\(id, reception_datetime, opened_at, pvz_id, status\\) VALUES \\(\\$1, \\$2, \\$2, \\$3, \\$4\\) RETURNING").
 		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), reception.PvzId, "in_progress").
-		WillReturnResult(sqlmock.NewResult(1, 1))
+		WillReturnRows(sqlmock.NewRows(receptionColumns).
+			AddRow(receptionID, reception.PvzId, "in_progress", time.Now().UTC(), nil, 0, nil, nil, false, "Asia/Yekaterinburg", 0, 0, 1))
 
 	result, err := s.repo.CreateReception(reception)
 
 	require.NoError(s.T(), err)
 	require.NotNil(s.T(), result)
 	require.Equal(s.T(), "in_progress", result.Status)
-	require.NotEqual(s.T(), uuid.UUID{}, result.Id)
+	require.Equal(s.T(), receptionID, result.Id)
+	require.Equal(s.T(), "Asia/Yekaterinburg", result.Timezone)
 	require.NotZero(s.T(), result.DateTime)
 	require.NoError(s.T(), s.mock.ExpectationsWereMet())
 }
@@ -105,7 +162,7 @@ func (s *ReceptionRepositoryTestSuite) TestCreateReception_DBError() {
 	}
 	dbErr := errors.New("database error")
 
-	s.mock.ExpectExec("INSERT INTO reception \\(id, reception_datetime, pvz_id, status\\) VALUES \\(\\$1, \\$2, \\$3, \\$4\\)").
+	s.mock.ExpectQuery("INSERT INTO reception AS r \\(id, reception_datetime, opened_at, pvz_id, status\\) VALUES \\(\\$1, \\$2, \\$2, \\$3, \\$4\\)").
 		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), reception.PvzId, "in_progress").
 		WillReturnError(dbErr)
 
@@ -123,9 +180,11 @@ func (s *ReceptionRepositoryTestSuite) TestCreateProduct_Success() {
 		ReceptionId: uuid.New(),
 	}
 
-	s.mock.ExpectExec("INSERT INTO product \\(id, product_type, acceptance_datetime, reception_id\\) VALUES \\(\\$1, \\$2, \\$3, \\$4\\)").
-		WithArgs(sqlmock.AnyArg(), product.Type, sqlmock.AnyArg(), product.ReceptionId).
-		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.expectCapacityLock(product.ReceptionId, nil)
+	s.mock.ExpectQuery("INSERT INTO product AS pr \\(\\s+id, product_type, acceptance_datetime, reception_id, barcode, weight_grams, length_cm, width_cm, height_cm\\s+\\)\\s+VALUES \\(\\$1, \\$2, \\$3, \\$4, \\$5, \\$6, \\$7, \\$8, \\$9\\)\\s+RETURNING").
+		WithArgs(sqlmock.AnyArg(), product.Type, sqlmock.AnyArg(), product.ReceptionId, product.Barcode, nil, nil, nil, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"timezone"}).AddRow("Europe/Moscow"))
+	s.mock.ExpectCommit()
 
 	result, err := s.repo.CreateProduct(product)
 
@@ -135,6 +194,29 @@ func (s *ReceptionRepositoryTestSuite) TestCreateProduct_Success() {
 	require.Equal(s.T(), product.ReceptionId, result.ReceptionId)
 	require.NotEqual(s.T(), uuid.UUID{}, result.Id)
 	require.NotZero(s.T(), result.DateTime)
+	require.Equal(s.T(), "Europe/Moscow", result.Timezone)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestCreateProduct_WithSize() {
+	weight := 1200
+	product := &entity.Product{
+		Type:        "обувь",
+		ReceptionId: uuid.New(),
+		WeightGrams: &weight,
+		Dimensions:  &entity.ProductDimensions{LengthCm: 30, WidthCm: 20, HeightCm: 12},
+	}
+
+	s.expectCapacityLock(product.ReceptionId, 1)
+	s.mock.ExpectQuery("INSERT INTO product AS pr").
+		WithArgs(sqlmock.AnyArg(), product.Type, sqlmock.AnyArg(), product.ReceptionId, product.Barcode, &weight, 30, 20, 12).
+		WillReturnRows(sqlmock.NewRows([]string{"timezone"}).AddRow("Europe/Moscow"))
+	s.mock.ExpectCommit()
+
+	result, err := s.repo.CreateProduct(product)
+
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(7200), result.VolumeCm3())
 	require.NoError(s.T(), s.mock.ExpectationsWereMet())
 }
 
@@ -145,9 +227,11 @@ func (s *ReceptionRepositoryTestSuite) TestCreateProduct_DBError() {
 	}
 	dbErr := errors.New("database error")
 
-	s.mock.ExpectExec("INSERT INTO product \\(id, product_type, acceptance_datetime, reception_id\\) VALUES \\(\\$1, \\$2, \\$3, \\$4\\)").
-		WithArgs(sqlmock.AnyArg(), product.Type, sqlmock.AnyArg(), product.ReceptionId).
+	s.expectCapacityLock(product.ReceptionId, nil)
+	s.mock.ExpectQuery("INSERT INTO product AS pr").
+		WithArgs(sqlmock.AnyArg(), product.Type, sqlmock.AnyArg(), product.ReceptionId, product.Barcode, nil, nil, nil, nil).
 		WillReturnError(dbErr)
+	s.mock.ExpectRollback()
 
 	result, err := s.repo.CreateProduct(product)
 
@@ -157,30 +241,86 @@ func (s *ReceptionRepositoryTestSuite) TestCreateProduct_DBError() {
 	require.NoError(s.T(), s.mock.ExpectationsWereMet())
 }
 
+func (s *ReceptionRepositoryTestSuite) TestCreateProduct_LimitReached() {
+	product := &entity.Product{
+		Type:        "smartphone",
+		ReceptionId: uuid.New(),
+	}
+
+	s.expectCapacityLock(product.ReceptionId, 0)
+	s.mock.ExpectRollback()
+
+	result, err := s.repo.CreateProduct(product)
+
+	require.Equal(s.T(), repository.ErrProductLimitReached, err)
+	require.Nil(s.T(), result)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestCreateProduct_ReceptionClosed() {
+	product := &entity.Product{
+		Type:        "smartphone",
+		ReceptionId: uuid.New(),
+	}
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectQuery("SELECT status FROM reception WHERE id = \\$1 FOR UPDATE").
+		WithArgs(product.ReceptionId).
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow(entity.ReceptionStatusClosed))
+	s.mock.ExpectRollback()
+
+	result, err := s.repo.CreateProduct(product)
+
+	require.Equal(s.T(), repository.ErrReceptionNotOpened, err)
+	require.Nil(s.T(), result)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestCreateProduct_DuplicateScan() {
+	barcode := "4006381333931"
+	product := &entity.Product{
+		Type:        "обувь",
+		ReceptionId: uuid.New(),
+		Barcode:     &barcode,
+	}
+
+	s.expectCapacityLock(product.ReceptionId, nil)
+	s.mock.ExpectQuery("INSERT INTO product AS pr").
+		WillReturnError(&pq.Error{Code: "23505", Constraint: "idx_product_reception_id_barcode"})
+	s.mock.ExpectRollback()
+
+	result, err := s.repo.CreateProduct(product)
+
+	require.Equal(s.T(), repository.ErrDuplicateScan, err)
+	require.Nil(s.T(), result)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
 func (s *ReceptionRepositoryTestSuite) TestDeleteLastProduct_Success() {
 	receptionID := uuid.New()
 
-	s.mock.ExpectExec("DELETE FROM product WHERE id = \\(SELECT id FROM product WHERE reception_id = \\$1 ORDER BY acceptance_datetime DESC LIMIT 1\\)").
+	s.mock.ExpectExec("DELETE FROM product WHERE id = \\(\\s+SELECT id FROM product WHERE reception_id = \\$1 AND status = 'accepted' ORDER BY acceptance_datetime DESC LIMIT 1\\s+\\)").
 		WithArgs(receptionID).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
-	err := s.repo.DeleteLastProduct(receptionID)
+	deleted, err := s.repo.DeleteLastProduct(receptionID)
 
 	require.NoError(s.T(), err)
+	require.True(s.T(), deleted)
 	require.NoError(s.T(), s.mock.ExpectationsWereMet())
 }
 
 func (s *ReceptionRepositoryTestSuite) TestDeleteLastProduct_NotFound() {
 	receptionID := uuid.New()
 
-	s.mock.ExpectExec("DELETE FROM product WHERE id = \\(SELECT id FROM product WHERE reception_id = \\$1 ORDER BY acceptance_datetime DESC LIMIT 1\\)").
+	s.mock.ExpectExec("DELETE FROM product WHERE id = \\(\\s+SELECT id FROM product WHERE reception_id = \\$1 AND status = 'accepted' ORDER BY acceptance_datetime DESC LIMIT 1\\s+\\)").
 		WithArgs(receptionID).
-		WillReturnError(sql.ErrNoRows)
+		WillReturnResult(sqlmock.NewResult(0, 0))
 
-	err := s.repo.DeleteLastProduct(receptionID)
+	deleted, err := s.repo.DeleteLastProduct(receptionID)
 
-	require.Error(s.T(), err)
-	require.Equal(s.T(), errors.New("product not found"), err)
+	require.NoError(s.T(), err)
+	require.False(s.T(), deleted)
 	require.NoError(s.T(), s.mock.ExpectationsWereMet())
 }
 
@@ -188,31 +328,84 @@ func (s *ReceptionRepositoryTestSuite) TestDeleteLastProduct_DBError() {
 	receptionID := uuid.New()
 	dbErr := errors.New("database error")
 
-	s.mock.ExpectExec("DELETE FROM product WHERE id = \\(SELECT id FROM product WHERE reception_id = \\$1 ORDER BY acceptance_datetime DESC LIMIT 1\\)").
+	s.mock.ExpectExec("DELETE FROM product WHERE id = \\(\\s+SELECT id FROM product WHERE reception_id = \\$1 AND status = 'accepted' ORDER BY acceptance_datetime DESC LIMIT 1\\s+\\)").
 		WithArgs(receptionID).
 		WillReturnError(dbErr)
 
-	err := s.repo.DeleteLastProduct(receptionID)
+	deleted, err := s.repo.DeleteLastProduct(receptionID)
 
 	require.Error(s.T(), err)
 	require.Equal(s.T(), dbErr, err)
+	require.False(s.T(), deleted)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestDeleteProduct_Success() {
+	receptionID := uuid.New()
+	productID := uuid.New()
+
+	s.mock.ExpectExec("DELETE FROM product WHERE reception_id = \\$1 AND id = \\$2 AND status = 'accepted'").
+		WithArgs(receptionID, productID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	deleted, err := s.repo.DeleteProduct(receptionID, productID)
+
+	require.NoError(s.T(), err)
+	require.True(s.T(), deleted)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestDeleteProduct_NotFound() {
+	receptionID := uuid.New()
+	productID := uuid.New()
+
+	s.mock.ExpectExec("DELETE FROM product WHERE reception_id = \\$1 AND id = \\$2 AND status = 'accepted'").
+		WithArgs(receptionID, productID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	deleted, err := s.repo.DeleteProduct(receptionID, productID)
+
+	require.NoError(s.T(), err)
+	require.False(s.T(), deleted)
 	require.NoError(s.T(), s.mock.ExpectationsWereMet())
 }
 
 func (s *ReceptionRepositoryTestSuite) TestCloseLastReception_Success() {
 	receptionID := uuid.New()
+	pvzID := uuid.New()
+	openedAt := time.Now().Add(-time.Hour)
+	closedAt := time.Now()
 
-	s.mock.ExpectExec("UPDATE reception SET status = 'closed' WHERE id = \\$1").
-		WithArgs(receptionID).
-		WillReturnResult(sqlmock.NewResult(1, 1))
+	s.mock.ExpectQuery("UPDATE reception r SET status = \\$2, closed_at = \\$3, version = r.version \\+ 1\\s+WHERE r.id = \\$1 AND r.version = \\$4\\s+RETURNING").
+		WithArgs(receptionID, "closed", sqlmock.AnyArg(), int64(1)).
+		WillReturnRows(sqlmock.NewRows(receptionColumns).
+			AddRow(receptionID, pvzID, "closed", openedAt, closedAt, 5, nil, nil, false, "Europe/Moscow", 0, 0, 1))
 
-	result, err := s.repo.CloseLastReception(receptionID)
+	result, err := s.repo.CloseLastReception(receptionID, 1)
 
 	require.NoError(s.T(), err)
 	require.NotNil(s.T(), result)
 	require.Equal(s.T(), receptionID, result.Id)
+	require.Equal(s.T(), pvzID, result.PvzId)
 	require.Equal(s.T(), "closed", result.Status)
-	require.NotZero(s.T(), result.DateTime)
+	require.Equal(s.T(), openedAt, result.DateTime)
+	require.Equal(s.T(), closedAt, *result.ClosedAt)
+	require.Equal(s.T(), 5, *result.ProductCount)
+	require.Equal(s.T(), closedAt.Sub(openedAt), *result.Duration())
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestCloseLastReception_VersionChanged() {
+	receptionID := uuid.New()
+
+	s.mock.ExpectQuery("UPDATE reception r SET status").
+		WithArgs(receptionID, "closed", sqlmock.AnyArg(), int64(1)).
+		WillReturnError(sql.ErrNoRows)
+
+	result, err := s.repo.CloseLastReception(receptionID, 1)
+
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), result)
 	require.NoError(s.T(), s.mock.ExpectationsWereMet())
 }
 
@@ -220,14 +413,318 @@ func (s *ReceptionRepositoryTestSuite) TestCloseLastReception_DBError() {
 	receptionID := uuid.New()
 	dbErr := errors.New("database error")
 
-	s.mock.ExpectExec("UPDATE reception SET status = 'closed' WHERE id = \\$1").
-		WithArgs(receptionID).
+	s.mock.ExpectQuery("UPDATE reception r SET status").
+		WithArgs(receptionID, "closed", sqlmock.AnyArg(), int64(1)).
 		WillReturnError(dbErr)
 
-	result, err := s.repo.CloseLastReception(receptionID)
+	result, err := s.repo.CloseLastReception(receptionID, 1)
 
 	require.Error(s.T(), err)
 	require.Equal(s.T(), dbErr, err)
 	require.Nil(s.T(), result)
 	require.NoError(s.T(), s.mock.ExpectationsWereMet())
 }
+
+func (s *ReceptionRepositoryTestSuite) TestGetReception_NotFound() {
+	receptionID := uuid.New()
+
+	s.mock.ExpectQuery("FROM reception r WHERE r.id = \\$1").
+		WithArgs(receptionID).
+		WillReturnError(sql.ErrNoRows)
+
+	result, err := s.repo.GetReception(receptionID)
+
+	require.Equal(s.T(), repository.ErrReceptionNotFound, err)
+	require.Nil(s.T(), result)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestReopenReception_Success() {
+	receptionID := uuid.New()
+
+	closedAfter := time.Now().Add(-time.Hour)
+
+	s.mock.ExpectQuery("UPDATE reception r SET status = \\$2, closed_at = NULL, auto_closed = false, opened_at = \\$4, version = r.version \\+ 1\\s+"+
+		"WHERE r.id = \\$1 AND r.version = \\$3 AND r.status = 'closed' AND r.closed_at >= \\$5\\s+AND NOT EXISTS").
+		WithArgs(receptionID, "in_progress", int64(1), sqlmock.AnyArg(), closedAfter).
+		WillReturnRows(sqlmock.NewRows(receptionColumns).
+			AddRow(receptionID, uuid.New(), "in_progress", time.Now(), nil, 2, nil, nil, false, "Europe/Moscow", 0, 0, 1))
+
+	result, err := s.repo.ReopenReception(receptionID, 1, closedAfter)
+
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), "in_progress", result.Status)
+	require.Nil(s.T(), result.ClosedAt)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestCancelReception_Success() {
+	receptionID := uuid.New()
+	reason := "opened by mistake"
+
+	s.mock.ExpectQuery("UPDATE reception r SET status = \\$2, cancel_reason = \\$3, cancelled_at = \\$4, version = r.version \\+ 1\\s+WHERE r.id = \\$1 AND r.version = \\$5").
+		WithArgs(receptionID, "cancelled", reason, sqlmock.AnyArg(), int64(2)).
+		WillReturnRows(sqlmock.NewRows(receptionColumns).
+			AddRow(receptionID, uuid.New(), "cancelled", time.Now(), nil, 3, reason, time.Now(), false, "Europe/Moscow", 0, 0, 1))
+
+	result, err := s.repo.CancelReception(receptionID, reason, 2)
+
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), "cancelled", result.Status)
+	require.Equal(s.T(), reason, *result.CancelReason)
+	require.Equal(s.T(), 3, *result.ProductCount)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestGetRemainingProductCapacity() {
+	receptionID := uuid.New()
+
+	s.mock.ExpectQuery("p.max_products_per_reception - \\(SELECT COUNT").
+		WithArgs(receptionID).
+		WillReturnRows(sqlmock.NewRows([]string{"capacity"}).AddRow(7))
+
+	capacity, err := s.repo.GetRemainingProductCapacity(receptionID)
+
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 7, *capacity)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestGetRemainingProductCapacity_Unlimited() {
+	receptionID := uuid.New()
+
+	s.mock.ExpectQuery("p.max_products_per_reception - \\(SELECT COUNT").
+		WithArgs(receptionID).
+		WillReturnRows(sqlmock.NewRows([]string{"capacity"}).AddRow(nil))
+
+	capacity, err := s.repo.GetRemainingProductCapacity(receptionID)
+
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), capacity)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestCreateProducts() {
+	receptionID := uuid.New()
+	barcode := "4006381333931"
+	products := []*entity.Product{
+		{Type: "обувь", ReceptionId: receptionID, Barcode: &barcode, Dimensions: &entity.ProductDimensions{LengthCm: 10, WidthCm: 10, HeightCm: 10}},
+		{Type: "одежда", ReceptionId: receptionID},
+	}
+
+	s.expectCapacityLock(receptionID, 2)
+	s.mock.ExpectQuery("SELECT barcode FROM product WHERE reception_id = \\$1 AND barcode = ANY\\(\\$2\\)").
+		WithArgs(receptionID, pq.Array([]string{barcode})).
+		WillReturnRows(sqlmock.NewRows([]string{"barcode"}))
+	s.mock.ExpectQuery("INSERT INTO product AS pr \\(.*\\) VALUES \\(\\$1, \\$2, \\$3, \\$4, \\$5, \\$6, \\$7, \\$8, \\$9\\), \\(\\$10, .*, \\$18\\) RETURNING pr.id").
+		WithArgs(
+			sqlmock.AnyArg(), "обувь", sqlmock.AnyArg(), receptionID, products[0].Barcode, nil, 10, 10, 10,
+			sqlmock.AnyArg(), "одежда", sqlmock.AnyArg(), receptionID, products[1].Barcode, nil, nil, nil, nil,
+		).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "timezone"}))
+	s.mock.ExpectCommit()
+
+	rejected, err := s.repo.CreateProducts(products, false)
+
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []error{nil, nil}, rejected)
+	require.NotEqual(s.T(), uuid.Nil, products[0].Id)
+	require.True(s.T(), products[1].DateTime.After(products[0].DateTime))
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestCreateProducts_PartialScannedConcurrently() {
+	receptionID := uuid.New()
+	scanned := "4006381333931"
+	fresh := "PVZ0123456789"
+	products := []*entity.Product{
+		{Type: "обувь", ReceptionId: receptionID, Barcode: &scanned},
+		{Type: "одежда", ReceptionId: receptionID, Barcode: &fresh},
+		{Type: "электроника", ReceptionId: receptionID},
+	}
+
+	s.expectCapacityLock(receptionID, 1)
+	s.mock.ExpectQuery("SELECT barcode FROM product WHERE reception_id = \\$1 AND barcode = ANY\\(\\$2\\)").
+		WithArgs(receptionID, pq.Array([]string{scanned, fresh})).
+		WillReturnRows(sqlmock.NewRows([]string{"barcode"}).AddRow(scanned))
+	s.mock.ExpectQuery("INSERT INTO product AS pr \\(.*\\) VALUES \\(\\$1, .*, \\$9\\) RETURNING pr.id").
+		WithArgs(sqlmock.AnyArg(), "одежда", sqlmock.AnyArg(), receptionID, products[1].Barcode, nil, nil, nil, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "timezone"}))
+	s.mock.ExpectCommit()
+
+	rejected, err := s.repo.CreateProducts(products, true)
+
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []error{repository.ErrDuplicateScan, nil, repository.ErrProductLimitReached}, rejected)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestCreateProducts_AtomicLimitReachedConcurrently() {
+	receptionID := uuid.New()
+	products := []*entity.Product{
+		{Type: "обувь", ReceptionId: receptionID},
+		{Type: "одежда", ReceptionId: receptionID},
+	}
+
+	s.expectCapacityLock(receptionID, 1)
+	s.mock.ExpectRollback()
+
+	rejected, err := s.repo.CreateProducts(products, false)
+
+	require.Equal(s.T(), repository.ErrProductLimitReached, err)
+	require.Nil(s.T(), rejected)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestCloseStaleReceptions() {
+	now := time.Now()
+
+	s.mock.ExpectExec("UPDATE reception r\\s+SET status = \\$2, closed_at = \\$1, auto_closed = true.+r.opened_at \\+ make_interval").
+		WithArgs(now, "closed", "in_progress").
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	closed, err := s.repo.CloseStaleReceptions(now)
+
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(3), closed)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestFindScannedBarcodes() {
+	receptionID := uuid.New()
+	barcodes := []string{"4006381333931", "PVZ0123456789"}
+
+	s.mock.ExpectQuery("SELECT barcode FROM product WHERE reception_id = \\$1 AND barcode = ANY\\(\\$2\\)").
+		WithArgs(receptionID, pq.Array(barcodes)).
+		WillReturnRows(sqlmock.NewRows([]string{"barcode"}).AddRow("PVZ0123456789"))
+
+	scanned, err := s.repo.FindScannedBarcodes(receptionID, barcodes)
+
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []string{"PVZ0123456789"}, scanned)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestGetProductsByBarcode() {
+	barcode := "PVZ0123456789"
+	productID := uuid.New()
+
+	s.mock.ExpectQuery("FROM product pr\\s+WHERE pr.barcode = \\$1").
+		WithArgs(barcode).
+		WillReturnRows(sqlmock.NewRows(productColumns).
+			AddRow(productID, "обувь", time.Now(), uuid.New(), barcode, 800, 30, 20, 10, "stored", time.Now(), nil, nil, nil, "Europe/Samara"))
+
+	products, err := s.repo.GetProductsByBarcode(barcode)
+
+	require.NoError(s.T(), err)
+	require.Len(s.T(), products, 1)
+	require.Equal(s.T(), productID, products[0].Id)
+	require.Equal(s.T(), barcode, *products[0].Barcode)
+	require.Equal(s.T(), "Europe/Samara", products[0].Timezone)
+	require.Equal(s.T(), 800, *products[0].WeightGrams)
+	require.Equal(s.T(), entity.ProductStatusStored, products[0].Status)
+	require.NotNil(s.T(), products[0].StoredAt)
+	require.Equal(s.T(), &entity.ProductDimensions{LengthCm: 30, WidthCm: 20, HeightCm: 10}, products[0].Dimensions)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestGetStorageUsage() {
+	receptionID := uuid.New()
+
+	s.mock.ExpectQuery("SELECT\\s+\\(\\s+SELECT COALESCE\\(SUM\\(.*rr.status <> 'cancelled'.*p.max_storage_volume_cm3,\\s+p.storage_limit_mode").
+		WithArgs(receptionID).
+		WillReturnRows(sqlmock.NewRows([]string{"used", "max_storage_volume_cm3", "storage_limit_mode"}).
+			AddRow(int64(9000), int64(10000), entity.StorageLimitModeWarn))
+
+	usage, err := s.repo.GetStorageUsage(receptionID)
+
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), int64(9000), usage.UsedVolumeCm3)
+	require.Equal(s.T(), entity.StorageLimitModeWarn, usage.LimitMode)
+	require.False(s.T(), usage.Exceeds(1000))
+	require.True(s.T(), usage.Exceeds(1001))
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestGetStorageUsage_NotFound() {
+	receptionID := uuid.New()
+
+	s.mock.ExpectQuery("p.storage_limit_mode").
+		WithArgs(receptionID).
+		WillReturnError(sql.ErrNoRows)
+
+	usage, err := s.repo.GetStorageUsage(receptionID)
+
+	require.ErrorIs(s.T(), err, repository.ErrReceptionNotFound)
+	require.Nil(s.T(), usage)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestUpdateProductStatus() {
+	productID := uuid.New()
+	issuedAt := time.Now().UTC()
+	storedAt := issuedAt.Add(-time.Hour)
+
+	s.mock.ExpectQuery("UPDATE product pr SET\\s+status = \\$3,.*WHERE pr.id = \\$1 AND pr.status = \\$2\\s+RETURNING").
+		WithArgs(productID, entity.ProductStatusStored, entity.ProductStatusIssued, issuedAt).
+		WillReturnRows(sqlmock.NewRows(productColumns).
+			AddRow(productID, "обувь", storedAt, uuid.New(), nil, nil, nil, nil, nil, "issued", storedAt, issuedAt, nil, nil, "Europe/Moscow"))
+
+	product, err := s.repo.UpdateProductStatus(productID, entity.ProductStatusStored, entity.ProductStatusIssued, issuedAt)
+
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), entity.ProductStatusIssued, product.Status)
+	require.Equal(s.T(), issuedAt, *product.IssuedAt)
+	require.Nil(s.T(), product.Dimensions)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestUpdateProductStatus_Changed() {
+	productID := uuid.New()
+
+	s.mock.ExpectQuery("UPDATE product pr SET").
+		WithArgs(productID, entity.ProductStatusAccepted, entity.ProductStatusStored, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows(productColumns))
+	s.mock.ExpectQuery("SELECT EXISTS \\(SELECT 1 FROM product WHERE id = \\$1\\)").
+		WithArgs(productID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	product, err := s.repo.UpdateProductStatus(productID, entity.ProductStatusAccepted, entity.ProductStatusStored, time.Now())
+
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), product)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestUpdateProductStatus_NotFound() {
+	productID := uuid.New()
+
+	s.mock.ExpectQuery("UPDATE product pr SET").
+		WithArgs(productID, entity.ProductStatusAccepted, entity.ProductStatusStored, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows(productColumns))
+	s.mock.ExpectQuery("SELECT EXISTS").
+		WithArgs(productID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	product, err := s.repo.UpdateProductStatus(productID, entity.ProductStatusAccepted, entity.ProductStatusStored, time.Now())
+
+	require.ErrorIs(s.T(), err, repository.ErrProductNotFound)
+	require.Nil(s.T(), product)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReceptionRepositoryTestSuite) TestGetProductsOnHand() {
+	pvzID := uuid.New()
+
+	s.mock.ExpectQuery("r.status <> 'cancelled' AND\\s+pr.status IN \\('accepted', 'stored'\\) AND").
+		WithArgs(pvzID, pq.Array([]string{entity.ProductStatusStored})).
+		WillReturnRows(sqlmock.NewRows(productColumns).
+			AddRow(uuid.New(), "обувь", time.Now(), uuid.New(), nil, nil, nil, nil, nil, "stored", time.Now(), nil, nil, nil, "Europe/Moscow"))
+
+	products, err := s.repo.GetProductsOnHand(pvzID, []string{entity.ProductStatusStored})
+
+	require.NoError(s.T(), err)
+	require.Len(s.T(), products, 1)
+	require.Equal(s.T(), entity.ProductStatusStored, products[0].Status)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
@@ -0,0 +1,361 @@
+package repository
+
+import (
+	"database/sql"
+	"log"
+	"time"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
+	"github.com/google/uuid"
+)
+
+// statsFilter ограничивает выборку приемок периодом, городом и ПВЗ.
+const statsFilter = `
+        r.status <> 'cancelled' AND 
+        ($1::timestamptz IS NULL OR r.reception_datetime >= $1) AND 
+        ($2::timestamptz IS NULL OR r.reception_datetime <= $2) AND 
+        ($3::varchar IS NULL OR p.city = $3) AND 
+        ($4::uuid IS NULL OR p.id = $4)`
+
+type ReportRepository struct {
+	db *sql.DB
+}
+
+func NewReportRepository(db *sql.DB) *ReportRepository {
+	return &ReportRepository{
+		db: db,
+	}
+}
+
+func (r *ReportRepository) GetStats(req *request.Stats) (*response.Stats, error) {
+	log.SetPrefix("repository.GetStats")
+
+	args := []any{req.StartDate, req.EndDate, req.City, req.PvzId}
+
+	receptionsQuery := `
+        SELECT 
+            COUNT(r.id),
+            COALESCE(AVG(EXTRACT(EPOCH FROM (r.closed_at - r.reception_datetime))), 0)
+        FROM 
+            reception r
+        JOIN 
+            pvz p ON p.id = r.pvz_id
+        WHERE ` + statsFilter
+
+	stats := &response.Stats{
+		ProductsByType: []response.ProductsByType{},
+		ProductsByDay:  []response.ProductsByDay{},
+	}
+
+	if err := r.db.QueryRow(receptionsQuery, args...).Scan(&stats.ReceptionCount, &stats.AverageReceptionDurationSeconds); err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	byTypeQuery := `
+        SELECT 
+            pr.product_type, COUNT(*)
+        FROM 
+            product pr
+        JOIN 
+            reception r ON r.id = pr.reception_id
+        JOIN 
+            pvz p ON p.id = r.pvz_id
+        WHERE ` + statsFilter + `
+        GROUP BY 
+            pr.product_type
+        ORDER BY 
+            pr.product_type`
+
+	rows, err := r.db.Query(byTypeQuery, args...)
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item response.ProductsByType
+		if err = rows.Scan(&item.Type, &item.Count); err != nil {
+			log.Printf("error: %v", err)
+
+			return nil, err
+		}
+
+		stats.ProductsByType = append(stats.ProductsByType, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	byDayQuery := `
+        SELECT 
+            to_char(pr.acceptance_datetime AT TIME ZONE c.timezone, 'YYYY-MM-DD') AS day, COUNT(*)
+        FROM 
+            product pr
+        JOIN 
+            reception r ON r.id = pr.reception_id
+        JOIN 
+            pvz p ON p.id = r.pvz_id
+        JOIN 
+            city c ON c.name = p.city
+        WHERE ` + statsFilter + `
+        GROUP BY 
+            day
+        ORDER BY 
+            day`
+
+	dayRows, err := r.db.Query(byDayQuery, args...)
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+	defer dayRows.Close()
+
+	for dayRows.Next() {
+		var item response.ProductsByDay
+		if err = dayRows.Scan(&item.Date, &item.Count); err != nil {
+			log.Printf("error: %v", err)
+
+			return nil, err
+		}
+
+		stats.ProductsByDay = append(stats.ProductsByDay, item)
+	}
+
+	if err = dayRows.Err(); err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// ExportReceptions построчно читает приемки с товарами и передает каждую строку в fn,
+// не загружая всю выборку в память.
+func (r *ReportRepository) ExportReceptions(req *request.Export, fn func(row *response.ExportRow) error) error {
+	log.SetPrefix("repository.ExportReceptions")
+
+	query := `
+        SELECT 
+            r.id, p.id, p.city, c.timezone, r.reception_datetime, r.status,
+            pr.id, pr.product_type, pr.acceptance_datetime
+        FROM 
+            reception r
+        JOIN 
+            pvz p ON p.id = r.pvz_id
+        JOIN 
+            city c ON c.name = p.city
+        LEFT JOIN 
+            product pr ON pr.reception_id = r.id
+        WHERE 
+            ($1::timestamptz IS NULL OR r.reception_datetime >= $1) AND 
+            ($2::timestamptz IS NULL OR r.reception_datetime <= $2) AND 
+            ($3::varchar IS NULL OR p.city = $3)
+        ORDER BY 
+            r.reception_datetime, r.id, pr.acceptance_datetime`
+
+	rows, err := r.db.Query(query, req.StartDate, req.EndDate, req.City)
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row response.ExportRow
+		var productId uuid.NullUUID
+		var productType sql.NullString
+		var productDateTime sql.NullTime
+
+		err = rows.Scan(
+			&row.ReceptionId, &row.PvzId, &row.City, &row.Timezone, &row.ReceptionDateTime, &row.ReceptionStatus,
+			&productId, &productType, &productDateTime,
+		)
+		if err != nil {
+			log.Printf("error: %v", err)
+
+			return err
+		}
+
+		if productId.Valid {
+			row.ProductId = &productId.UUID
+			row.ProductType = &productType.String
+			row.ProductDateTime = &productDateTime.Time
+		}
+
+		if err = fn(&row); err != nil {
+			return err
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("error: %v", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// BuildDailyReport пересчитывает сводку за день по всем ПВЗ, зарегистрированным к этой дате,
+// и отмечает день построенным, даже если ПВЗ еще не было. Берется календарная дата date,
+// границы дня — в часовом поясе города каждого ПВЗ.
+func (r *ReportRepository) BuildDailyReport(date time.Time) error {
+	log.SetPrefix("repository.BuildDailyReport")
+
+	query := `
+        INSERT INTO daily_report (report_date, pvz_id, reception_count, product_count, created_at)
+        SELECT 
+            $1::date, p.id, COUNT(DISTINCT r.id), COUNT(pr.id), $2
+        FROM 
+            pvz p
+        JOIN 
+            city c ON c.name = p.city
+        LEFT JOIN 
+            reception r ON r.pvz_id = p.id AND r.status <> 'cancelled' AND 
+            r.reception_datetime >= $1::date::timestamp AT TIME ZONE c.timezone AND 
+            r.reception_datetime < ($1::date + 1)::timestamp AT TIME ZONE c.timezone
+        LEFT JOIN 
+            product pr ON pr.reception_id = r.id
+        WHERE 
+            (p.registration_date AT TIME ZONE c.timezone)::date <= $1::date
+        GROUP BY 
+            p.id
+        ON CONFLICT (report_date, pvz_id) DO UPDATE SET
+            reception_count = EXCLUDED.reception_count,
+            product_count = EXCLUDED.product_count,
+            created_at = EXCLUDED.created_at`
+
+	runQuery := `
+        INSERT INTO daily_report_run (report_date, built_at)
+        VALUES ($1::date, $2)
+        ON CONFLICT (report_date) DO UPDATE SET built_at = EXCLUDED.built_at`
+
+	// Дата передается строкой: timestamptz при приведении к date сдвигается часовым поясом сессии.
+	day := date.Format(time.DateOnly)
+	now := time.Now().UTC()
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.Exec(query, day, now); err != nil {
+		log.Printf("error: %v", err)
+
+		return err
+	}
+
+	if _, err = tx.Exec(runQuery, day, now); err != nil {
+		log.Printf("error: %v", err)
+
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Printf("error: %v", err)
+
+		return err
+	}
+
+	return nil
+}
+
+func (r *ReportRepository) GetMissingDailyReportDates(from, to time.Time) ([]time.Time, error) {
+	log.SetPrefix("repository.GetMissingDailyReportDates")
+
+	query := `
+        SELECT 
+            d::date
+        FROM 
+            generate_series($1::date, $2::date, interval '1 day') d
+        WHERE 
+            NOT EXISTS (SELECT 1 FROM daily_report_run drr WHERE drr.report_date = d::date)
+        ORDER BY 
+            d`
+
+	rows, err := r.db.Query(query, from.Format(time.DateOnly), to.Format(time.DateOnly))
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+	defer rows.Close()
+
+	dates := make([]time.Time, 0)
+	for rows.Next() {
+		var date time.Time
+		if err = rows.Scan(&date); err != nil {
+			log.Printf("error: %v", err)
+
+			return nil, err
+		}
+
+		dates = append(dates, date)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	return dates, nil
+}
+
+func (r *ReportRepository) GetDailyReport(date time.Time) ([]response.DailyReport, error) {
+	log.SetPrefix("repository.GetDailyReport")
+
+	query := `
+        SELECT 
+            to_char(dr.report_date, 'YYYY-MM-DD'), dr.pvz_id, p.city, dr.reception_count, dr.product_count
+        FROM 
+            daily_report dr
+        JOIN 
+            pvz p ON p.id = dr.pvz_id
+        WHERE 
+            dr.report_date = $1::date
+        ORDER BY 
+            p.city, dr.pvz_id`
+
+	rows, err := r.db.Query(query, date.Format(time.DateOnly))
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+	defer rows.Close()
+
+	report := make([]response.DailyReport, 0)
+	for rows.Next() {
+		var item response.DailyReport
+		if err = rows.Scan(&item.Date, &item.PvzId, &item.City, &item.ReceptionCount, &item.ProductCount); err != nil {
+			log.Printf("error: %v", err)
+
+			return nil, err
+		}
+
+		report = append(report, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	return report, nil
+}
@@ -0,0 +1,182 @@
+package repository_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
+	"github.com/alexey-shedrin/avito-test-task/internal/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReportRepositoryTestSuite struct {
+	suite.Suite
+	db   *sql.DB
+	mock sqlmock.Sqlmock
+	repo *repository.ReportRepository
+}
+
+func (s *ReportRepositoryTestSuite) SetupTest() {
+	var err error
+	s.db, s.mock, err = sqlmock.New()
+	require.NoError(s.T(), err)
+	s.repo = repository.NewReportRepository(s.db)
+}
+
+func (s *ReportRepositoryTestSuite) TearDownTest() {
+	s.db.Close()
+}
+
+func TestReportRepositorySuite(t *testing.T) {
+	suite.Run(t, new(ReportRepositoryTestSuite))
+}
+
+func (s *ReportRepositoryTestSuite) TestGetStats_Success() {
+	city := "Москва"
+	pvzID := uuid.New()
+	req := &request.Stats{City: &city, PvzId: &pvzID}
+
+	s.mock.ExpectQuery("SELECT\\s+COUNT\\(r.id\\)").
+		WithArgs(nil, nil, &city, &pvzID).
+		WillReturnRows(sqlmock.NewRows([]string{"count", "avg"}).AddRow(3, 120.5))
+	s.mock.ExpectQuery("GROUP BY\\s+pr.product_type").
+		WithArgs(nil, nil, &city, &pvzID).
+		WillReturnRows(sqlmock.NewRows([]string{"product_type", "count"}).
+			AddRow("обувь", 4).
+			AddRow("одежда", 2))
+	s.mock.ExpectQuery("GROUP BY\\s+day").
+		WithArgs(nil, nil, &city, &pvzID).
+		WillReturnRows(sqlmock.NewRows([]string{"day", "count"}).AddRow("2025-04-20", 6))
+
+	stats, err := s.repo.GetStats(req)
+
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 3, stats.ReceptionCount)
+	require.Equal(s.T(), 120.5, stats.AverageReceptionDurationSeconds)
+	require.Len(s.T(), stats.ProductsByType, 2)
+	require.Equal(s.T(), "обувь", stats.ProductsByType[0].Type)
+	require.Len(s.T(), stats.ProductsByDay, 1)
+	require.Equal(s.T(), 6, stats.ProductsByDay[0].Count)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReportRepositoryTestSuite) TestGetStats_DBError() {
+	dbErr := errors.New("database error")
+
+	s.mock.ExpectQuery("SELECT\\s+COUNT\\(r.id\\)").
+		WillReturnError(dbErr)
+
+	stats, err := s.repo.GetStats(&request.Stats{})
+
+	require.Equal(s.T(), dbErr, err)
+	require.Nil(s.T(), stats)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReportRepositoryTestSuite) TestExportReceptions_Success() {
+	receptionID := uuid.New()
+	pvzID := uuid.New()
+	productID := uuid.New()
+	now := time.Now()
+
+	s.mock.ExpectQuery("FROM\\s+reception r\\s+JOIN\\s+pvz p ON p.id = r.pvz_id\\s+JOIN\\s+city c ON c.name = p.city\\s+LEFT JOIN\\s+product pr").
+		WithArgs(nil, nil, nil).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "id", "city", "timezone", "reception_datetime", "status",
+			"id", "product_type", "acceptance_datetime",
+		}).
+			AddRow(receptionID, pvzID, "Москва", "Europe/Moscow", now, "closed", productID, "обувь", now).
+			AddRow(uuid.New(), pvzID, "Москва", "Europe/Moscow", now, "in_progress", nil, nil, nil))
+
+	var rows []response.ExportRow
+	err := s.repo.ExportReceptions(&request.Export{}, func(row *response.ExportRow) error {
+		rows = append(rows, *row)
+		return nil
+	})
+
+	require.NoError(s.T(), err)
+	require.Len(s.T(), rows, 2)
+	require.Equal(s.T(), productID, *rows[0].ProductId)
+	require.Equal(s.T(), "обувь", *rows[0].ProductType)
+	require.Nil(s.T(), rows[1].ProductId)
+	require.Equal(s.T(), "Europe/Moscow", rows[1].Timezone)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReportRepositoryTestSuite) TestExportReceptions_CallbackError() {
+	callbackErr := errors.New("client disconnected")
+
+	s.mock.ExpectQuery("FROM\\s+reception r").
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "id", "city", "timezone", "reception_datetime", "status",
+			"id", "product_type", "acceptance_datetime",
+		}).
+			AddRow(uuid.New(), uuid.New(), "Москва", "Europe/Moscow", time.Now(), "closed", nil, nil, nil).
+			AddRow(uuid.New(), uuid.New(), "Москва", "Europe/Moscow", time.Now(), "closed", nil, nil, nil))
+
+	calls := 0
+	err := s.repo.ExportReceptions(&request.Export{}, func(row *response.ExportRow) error {
+		calls++
+		return callbackErr
+	})
+
+	require.Equal(s.T(), callbackErr, err)
+	require.Equal(s.T(), 1, calls)
+}
+
+func (s *ReportRepositoryTestSuite) TestBuildDailyReport_Success() {
+	date := time.Date(2025, 4, 20, 0, 0, 0, 0, time.UTC)
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("INSERT INTO daily_report .* ON CONFLICT \\(report_date, pvz_id\\) DO UPDATE").
+		WithArgs("2025-04-20", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	s.mock.ExpectExec("INSERT INTO daily_report_run").
+		WithArgs("2025-04-20", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+
+	err := s.repo.BuildDailyReport(date)
+
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReportRepositoryTestSuite) TestBuildDailyReport_NoPvz() {
+	date := time.Date(2025, 4, 20, 0, 0, 0, 0, time.UTC)
+
+	s.mock.ExpectBegin()
+	s.mock.ExpectExec("INSERT INTO daily_report ").
+		WithArgs("2025-04-20", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	s.mock.ExpectExec("INSERT INTO daily_report_run").
+		WithArgs("2025-04-20", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	s.mock.ExpectCommit()
+
+	err := s.repo.BuildDailyReport(date)
+
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
+
+func (s *ReportRepositoryTestSuite) TestGetMissingDailyReportDates_Success() {
+	from := time.Date(2025, 4, 18, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 4, 20, 0, 0, 0, 0, time.UTC)
+
+	s.mock.ExpectQuery("generate_series\\(\\$1::date, \\$2::date, interval '1 day'\\) .+ daily_report_run").
+		WithArgs("2025-04-18", "2025-04-20").
+		WillReturnRows(sqlmock.NewRows([]string{"d"}).AddRow(from).AddRow(to))
+
+	dates, err := s.repo.GetMissingDailyReportDates(from, to)
+
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []time.Time{from, to}, dates)
+	require.NoError(s.T(), s.mock.ExpectationsWereMet())
+}
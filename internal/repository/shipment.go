@@ -0,0 +1,274 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/database"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrShipmentNotFound      = apperror.NotFound("shipment_not_found", "shipment not found")
+	ErrShipmentAlreadyOpened = apperror.Conflict("shipment_already_opened", "shipment is already opened")
+	ErrShipmentNotOpened     = apperror.Conflict("shipment_not_opened", "shipment is not opened")
+)
+
+const shipmentColumns = `s.id, s.pvz_id, s.status, s.shipment_datetime, s.closed_at,
+            (SELECT COUNT(*) FROM product pr WHERE pr.shipment_id = s.id),
+            (SELECT c.timezone FROM pvz p JOIN city c ON c.name = p.city WHERE p.id = s.pvz_id)`
+
+// scanShipment читает строку, выбранную по shipmentColumns.
+func scanShipment(row *sql.Row) (*entity.Shipment, error) {
+	var shipment entity.Shipment
+	var productCount int
+
+	err := row.Scan(
+		&shipment.Id, &shipment.PvzId, &shipment.Status, &shipment.DateTime, &shipment.ClosedAt,
+		&productCount, &shipment.Timezone,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrShipmentNotFound
+		}
+
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	shipment.ProductCount = &productCount
+
+	return &shipment, nil
+}
+
+type ShipmentRepository struct {
+	db *sql.DB
+}
+
+func NewShipmentRepository(db *sql.DB) *ShipmentRepository {
+	return &ShipmentRepository{
+		db: db,
+	}
+}
+
+func (r *ShipmentRepository) GetOpenedShipmentId(pvzID uuid.UUID) (uuid.UUID, error) {
+	log.SetPrefix("repository.GetOpenedShipmentId")
+	query := `SELECT id FROM shipment WHERE pvz_id = $1 AND status = 'in_progress'`
+
+	id := uuid.UUID{}
+	if err := r.db.QueryRow(query, pvzID).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.UUID{}, nil
+		}
+
+		log.Printf("error: %v", err)
+
+		return uuid.UUID{}, err
+	}
+
+	return id, nil
+}
+
+// CreateShipment открывает отгрузку. В архивном или несуществующем ПВЗ возвращает ErrPvzNotFound.
+func (r *ShipmentRepository) CreateShipment(shipment *entity.Shipment) (*entity.Shipment, error) {
+	log.SetPrefix("repository.CreateShipment")
+	query := `
+        INSERT INTO shipment AS s (id, pvz_id, status, shipment_datetime)
+        SELECT $1, p.id, $3, $4 FROM pvz p WHERE p.id = $2 AND NOT p.archived
+        RETURNING ` + shipmentColumns
+
+	created, err := scanShipment(r.db.QueryRow(
+		query, uuid.New(), shipment.PvzId, entity.ShipmentStatusInProgress, time.Now().UTC(),
+	))
+	if err != nil {
+		if errors.Is(err, ErrShipmentNotFound) {
+			return nil, ErrPvzNotFound
+		}
+
+		if database.IsUniqueViolation(err) {
+			return nil, ErrShipmentAlreadyOpened
+		}
+
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (r *ShipmentRepository) GetShipment(shipmentID uuid.UUID) (*entity.Shipment, error) {
+	log.SetPrefix("repository.GetShipment")
+	query := `SELECT ` + shipmentColumns + ` FROM shipment s WHERE s.id = $1`
+
+	return scanShipment(r.db.QueryRow(query, shipmentID))
+}
+
+// GetProduct возвращает товар и id ПВЗ, в приемку которого он поступил.
+func (r *ShipmentRepository) GetProduct(productID uuid.UUID) (*entity.Product, uuid.UUID, error) {
+	log.SetPrefix("repository.GetShipmentProduct")
+	query := `
+        SELECT ` + productColumns + `, ` + productTimezone + `, r.pvz_id
+        FROM product pr
+        JOIN reception r ON r.id = pr.reception_id
+        WHERE pr.id = $1`
+
+	var product entity.Product
+	var dims [3]sql.NullInt64
+	var pvzID uuid.UUID
+
+	err := r.db.QueryRow(query, productID).Scan(append(productDest(&product, &dims), &product.Timezone, &pvzID)...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, uuid.UUID{}, ErrProductNotFound
+		}
+
+		log.Printf("error: %v", err)
+
+		return nil, uuid.UUID{}, err
+	}
+	setDimensions(&product, dims)
+
+	return &product, pvzID, nil
+}
+
+func (r *ShipmentRepository) GetShipmentProducts(shipmentID uuid.UUID) ([]*entity.Product, error) {
+	log.SetPrefix("repository.GetShipmentProducts")
+	query := `
+        SELECT ` + productColumns + `, ` + productTimezone + `
+        FROM product pr
+        WHERE pr.shipment_id = $1
+        ORDER BY pr.acceptance_datetime`
+
+	rows, err := r.db.Query(query, shipmentID)
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanProducts(rows)
+}
+
+// AttachProduct прикрепляет хранящийся товар к открытой отгрузке. Отгрузка блокируется до конца
+// транзакции, чтобы параллельное закрытие не пропустило товар. ErrProductNotFound означает,
+// что товар уже не хранится или прикреплен к другой отгрузке.
+func (r *ShipmentRepository) AttachProduct(shipmentID, productID uuid.UUID) (*entity.Product, error) {
+	log.SetPrefix("repository.AttachProduct")
+	query := `
+        UPDATE product pr SET shipment_id = $1
+        WHERE pr.id = $2 AND pr.status = 'stored' AND pr.shipment_id IS NULL
+            AND EXISTS (SELECT 1 FROM shipment WHERE id = $1 AND status = 'in_progress')
+        RETURNING ` + productColumns + `, ` + productTimezone
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err = lockOpenedShipment(tx, shipmentID, "FOR SHARE"); err != nil {
+		return nil, err
+	}
+
+	product, err := scanProduct(tx.QueryRow(query, shipmentID, productID))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// DetachProduct открепляет товар от отгрузки. false означает, что товара в отгрузке нет.
+func (r *ShipmentRepository) DetachProduct(shipmentID, productID uuid.UUID) (bool, error) {
+	log.SetPrefix("repository.DetachProduct")
+	query := `UPDATE product SET shipment_id = NULL WHERE shipment_id = $1 AND id = $2 AND status = 'stored'`
+
+	res, err := r.db.Exec(query, shipmentID, productID)
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// CloseShipment закрывает открытую отгрузку и одним запросом переводит ее товары в статус
+// returned_to_sender. Уже закрытая отгрузка дает ErrShipmentNotOpened.
+func (r *ShipmentRepository) CloseShipment(shipmentID uuid.UUID, at time.Time) (*entity.Shipment, error) {
+	log.SetPrefix("repository.CloseShipment")
+	query := `
+        WITH returned AS (
+            UPDATE product SET status = $3, returned_at = $2
+            WHERE shipment_id = $1 AND status = 'stored'
+        )
+        UPDATE shipment s SET status = $4, closed_at = $2
+        WHERE s.id = $1 AND s.status = 'in_progress'
+        RETURNING ` + shipmentColumns
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Блокировка ждет завершения параллельных AttachProduct: их товары попадут в закрытие.
+	if err = lockOpenedShipment(tx, shipmentID, "FOR UPDATE"); err != nil {
+		return nil, err
+	}
+
+	shipment, err := scanShipment(tx.QueryRow(
+		query, shipmentID, at, entity.ProductStatusReturnedToSender, entity.ShipmentStatusClosed,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Printf("error: %v", err)
+
+		return nil, err
+	}
+
+	return shipment, nil
+}
+
+// lockOpenedShipment блокирует строку открытой отгрузки до конца транзакции tx.
+func lockOpenedShipment(tx *sql.Tx, shipmentID uuid.UUID, lock string) error {
+	query := `SELECT id FROM shipment WHERE id = $1 AND status = 'in_progress' ` + lock
+
+	var id uuid.UUID
+	if err := tx.QueryRow(query, shipmentID).Scan(&id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrShipmentNotOpened
+		}
+
+		log.Printf("error: %v", err)
+
+		return err
+	}
+
+	return nil
+}
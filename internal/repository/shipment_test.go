@@ -0,0 +1,171 @@
+package repository_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/alexey-shedrin/avito-test-task/internal/repository"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+var shipmentColumns = []string{"id", "pvz_id", "status", "shipment_datetime", "closed_at", "count", "timezone"}
+
+func TestCreateShipment(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewShipmentRepository(db)
+	pvzID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectQuery("INSERT INTO shipment AS s \\(id, pvz_id, status, shipment_datetime\\)\\s+SELECT .* WHERE p.id = \\$2 AND NOT p.archived").
+			WithArgs(sqlmock.AnyArg(), pvzID, entity.ShipmentStatusInProgress, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows(shipmentColumns).
+				AddRow(uuid.New(), pvzID, entity.ShipmentStatusInProgress, time.Now().UTC(), nil, 0, "Europe/Moscow"))
+
+		result, err := repo.CreateShipment(&entity.Shipment{PvzId: pvzID})
+
+		require.NoError(t, err)
+		require.Equal(t, pvzID, result.PvzId)
+		require.Equal(t, 0, *result.ProductCount)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Archived pvz", func(t *testing.T) {
+		mock.ExpectQuery("INSERT INTO shipment").
+			WillReturnRows(sqlmock.NewRows(shipmentColumns))
+
+		result, err := repo.CreateShipment(&entity.Shipment{PvzId: pvzID})
+
+		require.Equal(t, repository.ErrPvzNotFound, err)
+		require.Nil(t, result)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Already opened", func(t *testing.T) {
+		mock.ExpectQuery("INSERT INTO shipment").
+			WillReturnError(&pq.Error{Code: "23505"})
+
+		result, err := repo.CreateShipment(&entity.Shipment{PvzId: pvzID})
+
+		require.Equal(t, repository.ErrShipmentAlreadyOpened, err)
+		require.Nil(t, result)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestGetShipmentProduct(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewShipmentRepository(db)
+	productID := uuid.New()
+	pvzID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectQuery("FROM product pr\\s+JOIN reception r ON r.id = pr.reception_id\\s+WHERE pr.id = \\$1").
+			WithArgs(productID).
+			WillReturnRows(sqlmock.NewRows(append(productColumns, "pvz_id")).
+				AddRow(productID, "обувь", time.Now(), uuid.New(), nil, nil, nil, nil, nil, "stored", time.Now(), nil, nil, nil, "Europe/Moscow", pvzID))
+
+		product, productPvzID, err := repo.GetProduct(productID)
+
+		require.NoError(t, err)
+		require.Equal(t, entity.ProductStatusStored, product.Status)
+		require.Equal(t, pvzID, productPvzID)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mock.ExpectQuery("FROM product pr").
+			WithArgs(productID).
+			WillReturnError(sql.ErrNoRows)
+
+		product, _, err := repo.GetProduct(productID)
+
+		require.Equal(t, repository.ErrProductNotFound, err)
+		require.Nil(t, product)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAttachProduct(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewShipmentRepository(db)
+	shipmentID := uuid.New()
+	productID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id FROM shipment WHERE id = \\$1 AND status = 'in_progress' FOR SHARE").
+		WithArgs(shipmentID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(shipmentID))
+	mock.ExpectQuery("UPDATE product pr SET shipment_id = \\$1\\s+WHERE pr.id = \\$2 AND pr.status = 'stored' AND pr.shipment_id IS NULL\\s+"+
+		"AND EXISTS \\(SELECT 1 FROM shipment WHERE id = \\$1 AND status = 'in_progress'\\)").
+		WithArgs(shipmentID, productID).
+		WillReturnRows(sqlmock.NewRows(productColumns).
+			AddRow(productID, "обувь", time.Now(), uuid.New(), nil, nil, nil, nil, nil, "stored", time.Now(), nil, nil, shipmentID, "Europe/Moscow"))
+	mock.ExpectCommit()
+
+	product, err := repo.AttachProduct(shipmentID, productID)
+
+	require.NoError(t, err)
+	require.Equal(t, shipmentID, *product.ShipmentId)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCloseShipment(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewShipmentRepository(db)
+	shipmentID := uuid.New()
+	now := time.Now().UTC()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id FROM shipment WHERE id = \\$1 AND status = 'in_progress' FOR UPDATE").
+		WithArgs(shipmentID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(shipmentID))
+	mock.ExpectQuery("WITH returned AS \\(\\s+UPDATE product SET status = \\$3, returned_at = \\$2\\s+WHERE shipment_id = \\$1 AND status = 'stored'\\s+\\)\\s+UPDATE shipment s SET status = \\$4, closed_at = \\$2\\s+WHERE s.id = \\$1 AND s.status = 'in_progress'").
+		WithArgs(shipmentID, now, entity.ProductStatusReturnedToSender, entity.ShipmentStatusClosed).
+		WillReturnRows(sqlmock.NewRows(shipmentColumns).
+			AddRow(shipmentID, uuid.New(), entity.ShipmentStatusClosed, now.Add(-time.Hour), now, 4, "Europe/Moscow"))
+	mock.ExpectCommit()
+
+	shipment, err := repo.CloseShipment(shipmentID, now)
+
+	require.NoError(t, err)
+	require.Equal(t, entity.ShipmentStatusClosed, shipment.Status)
+	require.Equal(t, 4, *shipment.ProductCount)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCloseShipment_AlreadyClosed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := repository.NewShipmentRepository(db)
+	shipmentID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id FROM shipment WHERE id = \\$1 AND status = 'in_progress' FOR UPDATE").
+		WithArgs(shipmentID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+
+	_, err = repo.CloseShipment(shipmentID, time.Now().UTC())
+
+	require.Equal(t, repository.ErrShipmentNotOpened, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -6,12 +6,13 @@ import (
 	"log"
 
 	"github.com/alexey-shedrin/avito-test-task/internal/database"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
 )
 
 var (
-	ErrUserAlreadyExists = errors.New("user already exists")
-	ErrUserNotFound      = errors.New("user not found")
+	ErrUserAlreadyExists = apperror.Conflict("user_already_exists", "user already exists")
+	ErrUserNotFound      = apperror.NotFound("user_not_found", "user not found")
 )
 
 type UserRepository struct {
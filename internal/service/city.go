@@ -0,0 +1,74 @@
+package service
+
+import (
+	"strings"
+	"time"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+)
+
+var (
+	InvalidCity     = apperror.Validation("invalid_city", "invalid city")
+	InvalidTimezone = apperror.Validation("invalid_timezone", "invalid timezone")
+)
+
+type CityRepository interface {
+	CreateCity(city *entity.City) (*entity.City, error)
+	GetCities(includeInactive bool) ([]*entity.City, error)
+	UpdateCity(name string, city *entity.City) (*entity.City, error)
+	DeleteCity(name string) error
+}
+
+type CityService struct {
+	cityRepo CityRepository
+}
+
+func NewCityService(cityRepo CityRepository) *CityService {
+	return &CityService{
+		cityRepo: cityRepo,
+	}
+}
+
+func (s *CityService) CreateCity(city *entity.City) (*entity.City, error) {
+	if err := validateCity(city); err != nil {
+		return nil, err
+	}
+
+	return s.cityRepo.CreateCity(city)
+}
+
+func (s *CityService) GetCities(includeInactive bool) ([]*entity.City, error) {
+	return s.cityRepo.GetCities(includeInactive)
+}
+
+func (s *CityService) UpdateCity(name string, city *entity.City) (*entity.City, error) {
+	if err := validateCity(city); err != nil {
+		return nil, err
+	}
+
+	return s.cityRepo.UpdateCity(name, city)
+}
+
+func (s *CityService) DeleteCity(name string) error {
+	return s.cityRepo.DeleteCity(name)
+}
+
+func validateCity(city *entity.City) error {
+	city.Name = strings.TrimSpace(city.Name)
+	city.Region = strings.TrimSpace(city.Region)
+
+	if city.Name == "" || city.Region == "" {
+		return InvalidCity
+	}
+
+	if city.Timezone == "" {
+		return InvalidTimezone
+	}
+
+	if _, err := time.LoadLocation(city.Timezone); err != nil {
+		return InvalidTimezone
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/alexey-shedrin/avito-test-task/internal/repository/mocks"
+	"github.com/alexey-shedrin/avito-test-task/internal/service"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCityService_CreateCity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockCityRepository(ctrl)
+	citySvc := service.NewCityService(mockRepo)
+
+	t.Run("Success", func(t *testing.T) {
+		city := &entity.City{Name: "Екатеринбург", Region: "Свердловская область", Timezone: "Asia/Yekaterinburg", Active: true}
+		mockRepo.EXPECT().CreateCity(city).Return(city, nil)
+
+		result, err := citySvc.CreateCity(city)
+
+		require.NoError(t, err)
+		require.Equal(t, city, result)
+	})
+
+	t.Run("Invalid timezone", func(t *testing.T) {
+		result, err := citySvc.CreateCity(&entity.City{Name: "Екатеринбург", Region: "Свердловская область", Timezone: "Asia/Ekb"})
+
+		require.Equal(t, service.InvalidTimezone, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Empty timezone", func(t *testing.T) {
+		result, err := citySvc.CreateCity(&entity.City{Name: "Екатеринбург", Region: "Свердловская область"})
+
+		require.Equal(t, service.InvalidTimezone, err)
+		require.Nil(t, result)
+	})
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/handler/city.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/handler/city.go -destination=internal/service/mocks/city.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	entity "github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCityService is a mock of CityService interface.
+type MockCityService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCityServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockCityServiceMockRecorder is the mock recorder for MockCityService.
+type MockCityServiceMockRecorder struct {
+	mock *MockCityService
+}
+
+// NewMockCityService creates a new mock instance.
+func NewMockCityService(ctrl *gomock.Controller) *MockCityService {
+	mock := &MockCityService{ctrl: ctrl}
+	mock.recorder = &MockCityServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCityService) EXPECT() *MockCityServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateCity mocks base method.
+func (m *MockCityService) CreateCity(city *entity.City) (*entity.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCity", city)
+	ret0, _ := ret[0].(*entity.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCity indicates an expected call of CreateCity.
+func (mr *MockCityServiceMockRecorder) CreateCity(city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCity", reflect.TypeOf((*MockCityService)(nil).CreateCity), city)
+}
+
+// DeleteCity mocks base method.
+func (m *MockCityService) DeleteCity(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCity", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCity indicates an expected call of DeleteCity.
+func (mr *MockCityServiceMockRecorder) DeleteCity(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCity", reflect.TypeOf((*MockCityService)(nil).DeleteCity), name)
+}
+
+// GetCities mocks base method.
+func (m *MockCityService) GetCities(includeInactive bool) ([]*entity.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCities", includeInactive)
+	ret0, _ := ret[0].([]*entity.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCities indicates an expected call of GetCities.
+func (mr *MockCityServiceMockRecorder) GetCities(includeInactive any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCities", reflect.TypeOf((*MockCityService)(nil).GetCities), includeInactive)
+}
+
+// UpdateCity mocks base method.
+func (m *MockCityService) UpdateCity(name string, city *entity.City) (*entity.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCity", name, city)
+	ret0, _ := ret[0].(*entity.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCity indicates an expected call of UpdateCity.
+func (mr *MockCityServiceMockRecorder) UpdateCity(name, city any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCity", reflect.TypeOf((*MockCityService)(nil).UpdateCity), name, city)
+}
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/handler/product_type.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/handler/product_type.go -destination=internal/service/mocks/product_type.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	entity "github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProductTypeService is a mock of ProductTypeService interface.
+type MockProductTypeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductTypeServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockProductTypeServiceMockRecorder is the mock recorder for MockProductTypeService.
+type MockProductTypeServiceMockRecorder struct {
+	mock *MockProductTypeService
+}
+
+// NewMockProductTypeService creates a new mock instance.
+func NewMockProductTypeService(ctrl *gomock.Controller) *MockProductTypeService {
+	mock := &MockProductTypeService{ctrl: ctrl}
+	mock.recorder = &MockProductTypeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductTypeService) EXPECT() *MockProductTypeServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateProductType mocks base method.
+func (m *MockProductTypeService) CreateProductType(productType *entity.ProductType) (*entity.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductType", productType)
+	ret0, _ := ret[0].(*entity.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProductType indicates an expected call of CreateProductType.
+func (mr *MockProductTypeServiceMockRecorder) CreateProductType(productType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductType", reflect.TypeOf((*MockProductTypeService)(nil).CreateProductType), productType)
+}
+
+// GetProductTypes mocks base method.
+func (m *MockProductTypeService) GetProductTypes(includeDeprecated bool) ([]*entity.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductTypes", includeDeprecated)
+	ret0, _ := ret[0].([]*entity.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductTypes indicates an expected call of GetProductTypes.
+func (mr *MockProductTypeServiceMockRecorder) GetProductTypes(includeDeprecated any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductTypes", reflect.TypeOf((*MockProductTypeService)(nil).GetProductTypes), includeDeprecated)
+}
+
+// SetProductTypeDeprecated mocks base method.
+func (m *MockProductTypeService) SetProductTypeDeprecated(name string, deprecated bool) (*entity.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProductTypeDeprecated", name, deprecated)
+	ret0, _ := ret[0].(*entity.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetProductTypeDeprecated indicates an expected call of SetProductTypeDeprecated.
+func (mr *MockProductTypeServiceMockRecorder) SetProductTypeDeprecated(name, deprecated any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProductTypeDeprecated", reflect.TypeOf((*MockProductTypeService)(nil).SetProductTypeDeprecated), name, deprecated)
+}
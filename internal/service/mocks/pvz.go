@@ -11,10 +11,11 @@ package mocks
 
 import (
 	reflect "reflect"
-	time "time"
 
+	request "github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
 	response "github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
 	entity "github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,6 +43,20 @@ func (m *MockPvzService) EXPECT() *MockPvzServiceMockRecorder {
 	return m.recorder
 }
 
+// ArchivePvz mocks base method.
+func (m *MockPvzService) ArchivePvz(pvzID uuid.UUID, versions []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchivePvz", pvzID, versions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchivePvz indicates an expected call of ArchivePvz.
+func (mr *MockPvzServiceMockRecorder) ArchivePvz(pvzID, versions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchivePvz", reflect.TypeOf((*MockPvzService)(nil).ArchivePvz), pvzID, versions)
+}
+
 // CreatePvz mocks base method.
 func (m *MockPvzService) CreatePvz(pvz *entity.Pvz) (*entity.Pvz, error) {
 	m.ctrl.T.Helper()
@@ -57,17 +72,62 @@ func (mr *MockPvzServiceMockRecorder) CreatePvz(pvz any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePvz", reflect.TypeOf((*MockPvzService)(nil).CreatePvz), pvz)
 }
 
+// GetNearbyPvz mocks base method.
+func (m *MockPvzService) GetNearbyPvz(req *request.GetNearbyPvz) ([]response.NearbyPvz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNearbyPvz", req)
+	ret0, _ := ret[0].([]response.NearbyPvz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNearbyPvz indicates an expected call of GetNearbyPvz.
+func (mr *MockPvzServiceMockRecorder) GetNearbyPvz(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNearbyPvz", reflect.TypeOf((*MockPvzService)(nil).GetNearbyPvz), req)
+}
+
 // GetPvz mocks base method.
-func (m *MockPvzService) GetPvz(startDate, endDate *time.Time, page, limit *int) ([]response.PvzInfo, error) {
+func (m *MockPvzService) GetPvz(req *request.GetPvz) ([]response.PvzInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPvz", startDate, endDate, page, limit)
+	ret := m.ctrl.Call(m, "GetPvz", req)
 	ret0, _ := ret[0].([]response.PvzInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPvz indicates an expected call of GetPvz.
-func (mr *MockPvzServiceMockRecorder) GetPvz(startDate, endDate, page, limit any) *gomock.Call {
+func (mr *MockPvzServiceMockRecorder) GetPvz(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPvz", reflect.TypeOf((*MockPvzService)(nil).GetPvz), req)
+}
+
+// UpdatePvz mocks base method.
+func (m *MockPvzService) UpdatePvz(pvzID uuid.UUID, update entity.PvzUpdate, versions []int64) (*entity.Pvz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePvz", pvzID, update, versions)
+	ret0, _ := ret[0].(*entity.Pvz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePvz indicates an expected call of UpdatePvz.
+func (mr *MockPvzServiceMockRecorder) UpdatePvz(pvzID, update, versions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePvz", reflect.TypeOf((*MockPvzService)(nil).UpdatePvz), pvzID, update, versions)
+}
+
+// UpdatePvzSettings mocks base method.
+func (m *MockPvzService) UpdatePvzSettings(pvzID uuid.UUID, settings entity.PvzSettings, versions []int64) (*entity.Pvz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePvzSettings", pvzID, settings, versions)
+	ret0, _ := ret[0].(*entity.Pvz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePvzSettings indicates an expected call of UpdatePvzSettings.
+func (mr *MockPvzServiceMockRecorder) UpdatePvzSettings(pvzID, settings, versions any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPvz", reflect.TypeOf((*MockPvzService)(nil).GetPvz), startDate, endDate, page, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePvzSettings", reflect.TypeOf((*MockPvzService)(nil).UpdatePvzSettings), pvzID, settings, versions)
 }
@@ -41,19 +41,49 @@ func (m *MockReceptionService) EXPECT() *MockReceptionServiceMockRecorder {
 	return m.recorder
 }
 
+// CancelReception mocks base method.
+func (m *MockReceptionService) CancelReception(receptionID uuid.UUID, reason string, versions []int64) (*entity.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelReception", receptionID, reason, versions)
+	ret0, _ := ret[0].(*entity.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelReception indicates an expected call of CancelReception.
+func (mr *MockReceptionServiceMockRecorder) CancelReception(receptionID, reason, versions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReception", reflect.TypeOf((*MockReceptionService)(nil).CancelReception), receptionID, reason, versions)
+}
+
+// ChangeProductStatus mocks base method.
+func (m *MockReceptionService) ChangeProductStatus(productID uuid.UUID, status string) (*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeProductStatus", productID, status)
+	ret0, _ := ret[0].(*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeProductStatus indicates an expected call of ChangeProductStatus.
+func (mr *MockReceptionServiceMockRecorder) ChangeProductStatus(productID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeProductStatus", reflect.TypeOf((*MockReceptionService)(nil).ChangeProductStatus), productID, status)
+}
+
 // CloseLastReception mocks base method.
-func (m *MockReceptionService) CloseLastReception(pvzID uuid.UUID) (*entity.Reception, error) {
+func (m *MockReceptionService) CloseLastReception(pvzID uuid.UUID, versions []int64) (*entity.Reception, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CloseLastReception", pvzID)
+	ret := m.ctrl.Call(m, "CloseLastReception", pvzID, versions)
 	ret0, _ := ret[0].(*entity.Reception)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CloseLastReception indicates an expected call of CloseLastReception.
-func (mr *MockReceptionServiceMockRecorder) CloseLastReception(pvzID any) *gomock.Call {
+func (mr *MockReceptionServiceMockRecorder) CloseLastReception(pvzID, versions any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseLastReception", reflect.TypeOf((*MockReceptionService)(nil).CloseLastReception), pvzID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseLastReception", reflect.TypeOf((*MockReceptionService)(nil).CloseLastReception), pvzID, versions)
 }
 
 // CreateProduct mocks base method.
@@ -71,6 +101,21 @@ func (mr *MockReceptionServiceMockRecorder) CreateProduct(product, pvzID any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProduct", reflect.TypeOf((*MockReceptionService)(nil).CreateProduct), product, pvzID)
 }
 
+// CreateProducts mocks base method.
+func (m *MockReceptionService) CreateProducts(products []*entity.Product, pvzID uuid.UUID, mode string) ([]entity.ProductBatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProducts", products, pvzID, mode)
+	ret0, _ := ret[0].([]entity.ProductBatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProducts indicates an expected call of CreateProducts.
+func (mr *MockReceptionServiceMockRecorder) CreateProducts(products, pvzID, mode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProducts", reflect.TypeOf((*MockReceptionService)(nil).CreateProducts), products, pvzID, mode)
+}
+
 // CreateReception mocks base method.
 func (m *MockReceptionService) CreateReception(reception *entity.Reception) (*entity.Reception, error) {
 	m.ctrl.T.Helper()
@@ -99,3 +144,62 @@ func (mr *MockReceptionServiceMockRecorder) DeleteLastProduct(pvzID any) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLastProduct", reflect.TypeOf((*MockReceptionService)(nil).DeleteLastProduct), pvzID)
 }
+
+// DeleteProduct mocks base method.
+func (m *MockReceptionService) DeleteProduct(receptionID, productID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProduct", receptionID, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProduct indicates an expected call of DeleteProduct.
+func (mr *MockReceptionServiceMockRecorder) DeleteProduct(receptionID, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockReceptionService)(nil).DeleteProduct), receptionID, productID)
+}
+
+// GetProductsByBarcode mocks base method.
+func (m *MockReceptionService) GetProductsByBarcode(barcode string) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductsByBarcode", barcode)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductsByBarcode indicates an expected call of GetProductsByBarcode.
+func (mr *MockReceptionServiceMockRecorder) GetProductsByBarcode(barcode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsByBarcode", reflect.TypeOf((*MockReceptionService)(nil).GetProductsByBarcode), barcode)
+}
+
+// GetProductsOnHand mocks base method.
+func (m *MockReceptionService) GetProductsOnHand(pvzID uuid.UUID, status string) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductsOnHand", pvzID, status)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductsOnHand indicates an expected call of GetProductsOnHand.
+func (mr *MockReceptionServiceMockRecorder) GetProductsOnHand(pvzID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsOnHand", reflect.TypeOf((*MockReceptionService)(nil).GetProductsOnHand), pvzID, status)
+}
+
+// ReopenReception mocks base method.
+func (m *MockReceptionService) ReopenReception(receptionID uuid.UUID, versions []int64) (*entity.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReopenReception", receptionID, versions)
+	ret0, _ := ret[0].(*entity.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReopenReception indicates an expected call of ReopenReception.
+func (mr *MockReceptionServiceMockRecorder) ReopenReception(receptionID, versions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReopenReception", reflect.TypeOf((*MockReceptionService)(nil).ReopenReception), receptionID, versions)
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/handler/report.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/handler/report.go -destination=internal/service/mocks/report.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+	time "time"
+
+	request "github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
+	response "github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReportService is a mock of ReportService interface.
+type MockReportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockReportServiceMockRecorder is the mock recorder for MockReportService.
+type MockReportServiceMockRecorder struct {
+	mock *MockReportService
+}
+
+// NewMockReportService creates a new mock instance.
+func NewMockReportService(ctrl *gomock.Controller) *MockReportService {
+	mock := &MockReportService{ctrl: ctrl}
+	mock.recorder = &MockReportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportService) EXPECT() *MockReportServiceMockRecorder {
+	return m.recorder
+}
+
+// ExportReceptions mocks base method.
+func (m *MockReportService) ExportReceptions(req *request.Export, fn func(*response.ExportRow) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportReceptions", req, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportReceptions indicates an expected call of ExportReceptions.
+func (mr *MockReportServiceMockRecorder) ExportReceptions(req, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportReceptions", reflect.TypeOf((*MockReportService)(nil).ExportReceptions), req, fn)
+}
+
+// GetDailyReport mocks base method.
+func (m *MockReportService) GetDailyReport(date time.Time) ([]response.DailyReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDailyReport", date)
+	ret0, _ := ret[0].([]response.DailyReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDailyReport indicates an expected call of GetDailyReport.
+func (mr *MockReportServiceMockRecorder) GetDailyReport(date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDailyReport", reflect.TypeOf((*MockReportService)(nil).GetDailyReport), date)
+}
+
+// GetStats mocks base method.
+func (m *MockReportService) GetStats(req *request.Stats) (*response.Stats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStats", req)
+	ret0, _ := ret[0].(*response.Stats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStats indicates an expected call of GetStats.
+func (mr *MockReportServiceMockRecorder) GetStats(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStats", reflect.TypeOf((*MockReportService)(nil).GetStats), req)
+}
@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/handler/shipment.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/handler/shipment.go -destination=internal/service/mocks/shipment.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	entity "github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockShipmentService is a mock of ShipmentService interface.
+type MockShipmentService struct {
+	ctrl     *gomock.Controller
+	recorder *MockShipmentServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockShipmentServiceMockRecorder is the mock recorder for MockShipmentService.
+type MockShipmentServiceMockRecorder struct {
+	mock *MockShipmentService
+}
+
+// NewMockShipmentService creates a new mock instance.
+func NewMockShipmentService(ctrl *gomock.Controller) *MockShipmentService {
+	mock := &MockShipmentService{ctrl: ctrl}
+	mock.recorder = &MockShipmentServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShipmentService) EXPECT() *MockShipmentServiceMockRecorder {
+	return m.recorder
+}
+
+// AddProduct mocks base method.
+func (m *MockShipmentService) AddProduct(shipmentID, productID uuid.UUID) (*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProduct", shipmentID, productID)
+	ret0, _ := ret[0].(*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddProduct indicates an expected call of AddProduct.
+func (mr *MockShipmentServiceMockRecorder) AddProduct(shipmentID, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProduct", reflect.TypeOf((*MockShipmentService)(nil).AddProduct), shipmentID, productID)
+}
+
+// CloseLastShipment mocks base method.
+func (m *MockShipmentService) CloseLastShipment(pvzID uuid.UUID) (*entity.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseLastShipment", pvzID)
+	ret0, _ := ret[0].(*entity.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseLastShipment indicates an expected call of CloseLastShipment.
+func (mr *MockShipmentServiceMockRecorder) CloseLastShipment(pvzID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseLastShipment", reflect.TypeOf((*MockShipmentService)(nil).CloseLastShipment), pvzID)
+}
+
+// CreateShipment mocks base method.
+func (m *MockShipmentService) CreateShipment(shipment *entity.Shipment) (*entity.Shipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShipment", shipment)
+	ret0, _ := ret[0].(*entity.Shipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShipment indicates an expected call of CreateShipment.
+func (mr *MockShipmentServiceMockRecorder) CreateShipment(shipment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShipment", reflect.TypeOf((*MockShipmentService)(nil).CreateShipment), shipment)
+}
+
+// DeleteProduct mocks base method.
+func (m *MockShipmentService) DeleteProduct(shipmentID, productID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProduct", shipmentID, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProduct indicates an expected call of DeleteProduct.
+func (mr *MockShipmentServiceMockRecorder) DeleteProduct(shipmentID, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockShipmentService)(nil).DeleteProduct), shipmentID, productID)
+}
+
+// GetShipment mocks base method.
+func (m *MockShipmentService) GetShipment(shipmentID uuid.UUID) (*entity.Shipment, []*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShipment", shipmentID)
+	ret0, _ := ret[0].(*entity.Shipment)
+	ret1, _ := ret[1].([]*entity.Product)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetShipment indicates an expected call of GetShipment.
+func (mr *MockShipmentServiceMockRecorder) GetShipment(shipmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShipment", reflect.TypeOf((*MockShipmentService)(nil).GetShipment), shipmentID)
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+)
+
+type ProductTypeRepository interface {
+	CreateProductType(productType *entity.ProductType) (*entity.ProductType, error)
+	GetProductTypes(includeDeprecated bool) ([]*entity.ProductType, error)
+	SetProductTypeDeprecated(name string, deprecated bool) (*entity.ProductType, error)
+}
+
+type ProductTypeService struct {
+	productTypeRepo ProductTypeRepository
+}
+
+func NewProductTypeService(productTypeRepo ProductTypeRepository) *ProductTypeService {
+	return &ProductTypeService{
+		productTypeRepo: productTypeRepo,
+	}
+}
+
+func (s *ProductTypeService) CreateProductType(productType *entity.ProductType) (*entity.ProductType, error) {
+	productType.Name = strings.TrimSpace(productType.Name)
+	if productType.Name == "" {
+		return nil, InvalidProductType
+	}
+
+	return s.productTypeRepo.CreateProductType(productType)
+}
+
+func (s *ProductTypeService) GetProductTypes(includeDeprecated bool) ([]*entity.ProductType, error) {
+	return s.productTypeRepo.GetProductTypes(includeDeprecated)
+}
+
+func (s *ProductTypeService) SetProductTypeDeprecated(name string, deprecated bool) (*entity.ProductType, error) {
+	return s.productTypeRepo.SetProductTypeDeprecated(name, deprecated)
+}
@@ -0,0 +1,36 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/alexey-shedrin/avito-test-task/internal/repository/mocks"
+	"github.com/alexey-shedrin/avito-test-task/internal/service"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestProductTypeService_CreateProductType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockProductTypeRepository(ctrl)
+	productTypeSvc := service.NewProductTypeService(mockRepo)
+
+	t.Run("Trims name", func(t *testing.T) {
+		expected := &entity.ProductType{Name: "косметика"}
+		mockRepo.EXPECT().CreateProductType(expected).Return(expected, nil)
+
+		result, err := productTypeSvc.CreateProductType(&entity.ProductType{Name: "  косметика "})
+
+		require.NoError(t, err)
+		require.Equal(t, expected, result)
+	})
+
+	t.Run("Empty name", func(t *testing.T) {
+		result, err := productTypeSvc.CreateProductType(&entity.ProductType{Name: "   "})
+
+		require.Equal(t, service.InvalidProductType, err)
+		require.Nil(t, result)
+	})
+}
@@ -4,13 +4,34 @@ import (
 	"time"
 
 	"github.com/alexey-shedrin/avito-test-task/internal/metrics"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/google/uuid"
+)
+
+var (
+	InvalidView = apperror.Validation("invalid_view", "invalid view")
+	// InvalidCoordinates координаты меняются только парой.
+	InvalidCoordinates = apperror.Validation("invalid_coordinates", "latitude and longitude must be set together")
+	InvalidNearbyQuery = apperror.Validation("invalid_nearby_query", "invalid coordinates, radius or limit")
+	PvzArchived        = apperror.Conflict("pvz_archived", "pvz is archived")
+	// PvzHasOpenedReception архивировать можно только ПВЗ без незакрытой приемки.
+	PvzHasOpenedReception = apperror.Conflict("pvz_has_opened_reception", "pvz has an opened reception")
+	// VersionMismatch версия ПВЗ или приемки не совпала с переданной в If-Match.
+	VersionMismatch = apperror.PreconditionFailed("version_mismatch", "resource version does not match If-Match")
 )
 
 type PVZRepository interface {
 	CreatePvz(pvz *entity.Pvz) (*entity.Pvz, error)
-	GetPvz(startDate, endDate *time.Time, page, limit *int) ([]response.PvzInfo, error)
+	IsActiveCity(name string) (bool, error)
+	GetPvz(req *request.GetPvz) ([]response.PvzInfo, error)
+	GetNearbyPvz(req *request.GetNearbyPvz) ([]response.NearbyPvz, error)
+	UpdatePvzSettings(pvzID uuid.UUID, settings entity.PvzSettings, versions []int64) (*entity.Pvz, error)
+	UpdatePvz(pvzID uuid.UUID, update entity.PvzUpdate, versions []int64) (*entity.Pvz, error)
+	HasOpenedReception(pvzID uuid.UUID) (bool, error)
+	ArchivePvz(pvzID uuid.UUID, at time.Time, versions []int64) (bool, error)
 }
 
 type PVZService struct {
@@ -24,7 +45,16 @@ func NewPVZService(pvzRepo PVZRepository) *PVZService {
 }
 
 func (s *PVZService) CreatePvz(pvz *entity.Pvz) (*entity.Pvz, error) {
-	pvz, err := s.pvzRepo.CreatePvz(pvz)
+	active, err := s.pvzRepo.IsActiveCity(pvz.City)
+	if err != nil {
+		return nil, err
+	}
+
+	if !active {
+		return nil, InvalidCity
+	}
+
+	pvz, err = s.pvzRepo.CreatePvz(pvz)
 	if err != nil {
 		return nil, err
 	}
@@ -34,6 +64,107 @@ func (s *PVZService) CreatePvz(pvz *entity.Pvz) (*entity.Pvz, error) {
 	return pvz, nil
 }
 
-func (s *PVZService) GetPvz(startDate, endDate *time.Time, page, limit *int) ([]response.PvzInfo, error) {
-	return s.pvzRepo.GetPvz(startDate, endDate, page, limit)
+func (s *PVZService) GetPvz(req *request.GetPvz) ([]response.PvzInfo, error) {
+	if req.Sort == "" {
+		req.Sort = entity.PvzSortRegistrationDate
+	}
+
+	if req.Order == "" {
+		req.Order = entity.SortOrderAsc
+	}
+
+	if req.View == "" {
+		req.View = entity.PvzViewFull
+	}
+
+	if !entity.IsValidPvzView(req.View) {
+		return nil, InvalidView
+	}
+
+	return s.pvzRepo.GetPvz(req)
+}
+
+func (s *PVZService) GetNearbyPvz(req *request.GetNearbyPvz) ([]response.NearbyPvz, error) {
+	if req.Radius == 0 {
+		req.Radius = entity.PvzNearbyDefaultRadius
+	}
+
+	if req.Limit == 0 {
+		req.Limit = entity.PvzNearbyDefaultLimit
+	}
+
+	if !entity.IsValidCoordinates(req.Latitude, req.Longitude) ||
+		req.Radius < 0 || req.Radius > entity.PvzNearbyMaxRadius ||
+		req.Limit < 0 || req.Limit > entity.PvzNearbyMaxLimit {
+		return nil, InvalidNearbyQuery
+	}
+
+	return s.pvzRepo.GetNearbyPvz(req)
+}
+
+// UpdatePvzSettings меняет ограничения ПВЗ. versions - допустимые версии ПВЗ из If-Match, nil не проверяется.
+func (s *PVZService) UpdatePvzSettings(pvzID uuid.UUID, settings entity.PvzSettings, versions []int64) (*entity.Pvz, error) {
+	if settings.StorageLimitMode == "" {
+		settings.StorageLimitMode = entity.StorageLimitModeReject
+	}
+
+	pvz, err := s.pvzRepo.UpdatePvzSettings(pvzID, settings, versions)
+	if err != nil {
+		return nil, err
+	}
+
+	if pvz == nil {
+		return nil, VersionMismatch
+	}
+
+	return pvz, nil
+}
+
+func (s *PVZService) UpdatePvz(pvzID uuid.UUID, update entity.PvzUpdate, versions []int64) (*entity.Pvz, error) {
+	if (update.Latitude == nil) != (update.Longitude == nil) {
+		return nil, InvalidCoordinates
+	}
+
+	pvz, err := s.pvzRepo.UpdatePvz(pvzID, update, versions)
+	if err != nil {
+		return nil, err
+	}
+
+	if pvz == nil {
+		return nil, VersionMismatch
+	}
+
+	return pvz, nil
+}
+
+func (s *PVZService) ArchivePvz(pvzID uuid.UUID, versions []int64) error {
+	opened, err := s.pvzRepo.HasOpenedReception(pvzID)
+	if err != nil {
+		return err
+	}
+
+	if opened {
+		return PvzHasOpenedReception
+	}
+
+	archived, err := s.pvzRepo.ArchivePvz(pvzID, time.Now().UTC(), versions)
+	if err != nil {
+		return err
+	}
+
+	if archived {
+		return nil
+	}
+
+	// Приемку могли открыть между проверкой и архивацией.
+	opened, err = s.pvzRepo.HasOpenedReception(pvzID)
+	if err != nil {
+		return err
+	}
+
+	if opened {
+		return PvzHasOpenedReception
+	}
+
+	return VersionMismatch
 }
@@ -0,0 +1,174 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/alexey-shedrin/avito-test-task/internal/repository/mocks"
+	"github.com/alexey-shedrin/avito-test-task/internal/service"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPVZService_CreatePvz(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockPVZRepository(ctrl)
+	pvzSvc := service.NewPVZService(mockRepo)
+
+	t.Run("Success", func(t *testing.T) {
+		pvz := &entity.Pvz{City: "Казань"}
+		created := &entity.Pvz{Id: uuid.New(), City: "Казань"}
+
+		mockRepo.EXPECT().IsActiveCity("Казань").Return(true, nil)
+		mockRepo.EXPECT().CreatePvz(pvz).Return(created, nil)
+
+		result, err := pvzSvc.CreatePvz(pvz)
+
+		require.NoError(t, err)
+		require.Equal(t, created, result)
+	})
+
+	t.Run("Inactive city", func(t *testing.T) {
+		mockRepo.EXPECT().IsActiveCity("Тверь").Return(false, nil)
+
+		result, err := pvzSvc.CreatePvz(&entity.Pvz{City: "Тверь"})
+
+		require.Equal(t, service.InvalidCity, err)
+		require.Nil(t, result)
+	})
+}
+
+func TestPVZService_UpdatePvz(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockPVZRepository(ctrl)
+	pvzSvc := service.NewPVZService(mockRepo)
+
+	t.Run("Success", func(t *testing.T) {
+		pvzID := uuid.New()
+		latitude, longitude := 55.75, 37.62
+		update := entity.PvzUpdate{Latitude: &latitude, Longitude: &longitude}
+		updated := &entity.Pvz{Id: pvzID, Latitude: &latitude, Longitude: &longitude}
+
+		mockRepo.EXPECT().UpdatePvz(pvzID, update, nil).Return(updated, nil)
+
+		result, err := pvzSvc.UpdatePvz(pvzID, update, nil)
+
+		require.NoError(t, err)
+		require.Equal(t, updated, result)
+	})
+
+	t.Run("Version mismatch", func(t *testing.T) {
+		pvzID := uuid.New()
+		versions := []int64{2}
+		update := entity.PvzUpdate{}
+
+		mockRepo.EXPECT().UpdatePvz(pvzID, update, versions).Return(nil, nil)
+
+		result, err := pvzSvc.UpdatePvz(pvzID, update, versions)
+
+		require.Equal(t, service.VersionMismatch, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Latitude without longitude", func(t *testing.T) {
+		latitude := 55.75
+
+		result, err := pvzSvc.UpdatePvz(uuid.New(), entity.PvzUpdate{Latitude: &latitude}, nil)
+
+		require.Equal(t, service.InvalidCoordinates, err)
+		require.Nil(t, result)
+	})
+}
+
+func TestPVZService_GetNearbyPvz(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockPVZRepository(ctrl)
+	pvzSvc := service.NewPVZService(mockRepo)
+
+	t.Run("Defaults", func(t *testing.T) {
+		req := &request.GetNearbyPvz{Latitude: 55.75, Longitude: 37.62}
+		expected := []response.NearbyPvz{{Pvz: response.Pvz{Id: uuid.New()}, Distance: 120}}
+
+		mockRepo.EXPECT().GetNearbyPvz(&request.GetNearbyPvz{
+			Latitude:  55.75,
+			Longitude: 37.62,
+			Radius:    entity.PvzNearbyDefaultRadius,
+			Limit:     entity.PvzNearbyDefaultLimit,
+		}).Return(expected, nil)
+
+		result, err := pvzSvc.GetNearbyPvz(req)
+
+		require.NoError(t, err)
+		require.Equal(t, expected, result)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for name, req := range map[string]*request.GetNearbyPvz{
+			"latitude":  {Latitude: 91, Longitude: 37.62},
+			"longitude": {Latitude: 55.75, Longitude: -181},
+			"radius":    {Latitude: 55.75, Longitude: 37.62, Radius: entity.PvzNearbyMaxRadius + 1},
+			"limit":     {Latitude: 55.75, Longitude: 37.62, Limit: -1},
+		} {
+			result, err := pvzSvc.GetNearbyPvz(req)
+
+			require.Equal(t, service.InvalidNearbyQuery, err, name)
+			require.Nil(t, result, name)
+		}
+	})
+}
+
+func TestPVZService_ArchivePvz(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockPVZRepository(ctrl)
+	pvzSvc := service.NewPVZService(mockRepo)
+
+	t.Run("Success", func(t *testing.T) {
+		pvzID := uuid.New()
+
+		mockRepo.EXPECT().HasOpenedReception(pvzID).Return(false, nil)
+		mockRepo.EXPECT().ArchivePvz(pvzID, gomock.Any(), nil).Return(true, nil)
+
+		require.NoError(t, pvzSvc.ArchivePvz(pvzID, nil))
+	})
+
+	t.Run("Version mismatch", func(t *testing.T) {
+		pvzID := uuid.New()
+		versions := []int64{4}
+
+		mockRepo.EXPECT().HasOpenedReception(pvzID).Return(false, nil).Times(2)
+		mockRepo.EXPECT().ArchivePvz(pvzID, gomock.Any(), versions).Return(false, nil)
+
+		require.Equal(t, service.VersionMismatch, pvzSvc.ArchivePvz(pvzID, versions))
+	})
+
+	t.Run("Reception opened concurrently", func(t *testing.T) {
+		pvzID := uuid.New()
+
+		gomock.InOrder(
+			mockRepo.EXPECT().HasOpenedReception(pvzID).Return(false, nil),
+			mockRepo.EXPECT().ArchivePvz(pvzID, gomock.Any(), nil).Return(false, nil),
+			mockRepo.EXPECT().HasOpenedReception(pvzID).Return(true, nil),
+		)
+
+		require.Equal(t, service.PvzHasOpenedReception, pvzSvc.ArchivePvz(pvzID, nil))
+	})
+
+	t.Run("Opened reception", func(t *testing.T) {
+		pvzID := uuid.New()
+
+		mockRepo.EXPECT().HasOpenedReception(pvzID).Return(true, nil)
+
+		require.Equal(t, service.PvzHasOpenedReception, pvzSvc.ArchivePvz(pvzID, nil))
+	})
+}
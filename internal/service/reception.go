@@ -1,52 +1,100 @@
 package service
 
 import (
-	"context"
-	"database/sql"
-	"errors"
 	"log"
+	"slices"
+	"time"
 
 	"github.com/alexey-shedrin/avito-test-task/internal/metrics"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/alexey-shedrin/avito-test-task/internal/repository"
 	"github.com/google/uuid"
 )
 
 var (
-	ReceptionAlreadyOpened = errors.New("reception is already opened")
-	ReceptionNotOpened     = errors.New("reception is not opened")
-	ReceptionAlreadyClosed = errors.New("reception is already closed")
+	ReceptionAlreadyOpened = apperror.Conflict("reception_already_opened", "reception is already opened")
+	PvzNotActive           = apperror.Conflict("pvz_not_active", "pvz is suspended or closed")
+	ReceptionNotOpened     = repository.ErrReceptionNotOpened
+	ReceptionAlreadyClosed = apperror.Conflict("reception_already_closed", "reception is already closed")
+	ReceptionNotClosed     = apperror.Conflict("reception_not_closed", "reception is not closed")
+	ReceptionNotLatest     = apperror.Conflict("reception_not_latest", "a newer reception exists for this pvz")
+	ReopenWindowExpired    = apperror.Conflict("reopen_window_expired", "reception was closed too long ago to reopen")
+	ProductLimitReached    = repository.ErrProductLimitReached
+	ProductNotFound        = repository.ErrProductNotFound
+	InvalidProductType     = apperror.Validation("invalid_product_type", "invalid product type")
+	ProductBatchRejected   = apperror.Unprocessable("product_batch_rejected", "product batch rejected")
+	InvalidBarcode         = apperror.Validation("invalid_barcode", "invalid barcode")
+	DuplicateScan          = repository.ErrDuplicateScan
+	InvalidProductSize     = apperror.Validation("invalid_product_size", "weight and dimensions must be positive")
+	StorageLimitReached    = apperror.Conflict("storage_limit_reached", "storage volume limit for pvz is reached")
+	InvalidProductStatus   = apperror.Validation("invalid_product_status", "invalid product status")
+	ProductStatusConflict  = apperror.Conflict("product_status_conflict", "product status transition is not allowed")
 )
 
 type ReceptionRepository interface {
+	GetPvzStatus(pvzID uuid.UUID) (string, bool, error)
 	GetOpenedReceptionId(pvzID uuid.UUID) (uuid.UUID, error)
 	CreateReception(reception *entity.Reception) (*entity.Reception, error)
 	CreateProduct(product *entity.Product) (*entity.Product, error)
-	DeleteLastProduct(pvzID uuid.UUID) error
-	CloseLastReception(receptionId uuid.UUID) (*entity.Reception, error)
+	CreateProducts(products []*entity.Product, partial bool) ([]error, error)
+	GetRemainingProductCapacity(receptionID uuid.UUID) (*int, error)
+	GetStorageUsage(receptionID uuid.UUID) (*entity.StorageUsage, error)
+	GetActiveProductTypes() ([]string, error)
+	FindScannedBarcodes(receptionID uuid.UUID, barcodes []string) ([]string, error)
+	GetProductsByBarcode(barcode string) ([]*entity.Product, error)
+	GetProduct(productID uuid.UUID) (*entity.Product, error)
+	UpdateProductStatus(productID uuid.UUID, from, to string, at time.Time) (*entity.Product, error)
+	GetProductsOnHand(pvzID uuid.UUID, statuses []string) ([]*entity.Product, error)
+	DeleteLastProduct(receptionID uuid.UUID) (bool, error)
+	DeleteProduct(receptionID, productID uuid.UUID) (bool, error)
+	CloseLastReception(receptionId uuid.UUID, version int64) (*entity.Reception, error)
+	GetReception(receptionID uuid.UUID) (*entity.Reception, error)
+	HasNewerReception(pvzID uuid.UUID, after time.Time) (bool, error)
+	ReopenReception(receptionID uuid.UUID, version int64, closedAfter time.Time) (*entity.Reception, error)
+	CancelReception(receptionID uuid.UUID, reason string, version int64) (*entity.Reception, error)
+	CloseStaleReceptions(now time.Time) (int64, error)
 }
 
+// defaultReopenWindow время после закрытия, в течение которого приемку можно открыть снова.
+const defaultReopenWindow = 24 * time.Hour
+
 type ReceptionService struct {
 	receptionRepo ReceptionRepository
-	db            *sql.DB
+	reopenWindow  time.Duration
 }
 
-func NewReceptionService(receptionRepo ReceptionRepository, db *sql.DB) *ReceptionService {
+func NewReceptionService(receptionRepo ReceptionRepository) *ReceptionService {
 	return &ReceptionService{
 		receptionRepo: receptionRepo,
-		db:            db,
+		reopenWindow:  defaultReopenWindow,
+	}
+}
+
+// WithReopenWindow задает время после закрытия, в течение которого приемку можно открыть снова.
+func (s *ReceptionService) WithReopenWindow(window time.Duration) *ReceptionService {
+	if window > 0 {
+		s.reopenWindow = window
 	}
+
+	return s
 }
 
 func (s *ReceptionService) CreateReception(reception *entity.Reception) (*entity.Reception, error) {
 	log.SetPrefix("ReceptionService.CreateReception")
 
-	tx, err := s.db.BeginTx(context.Background(), nil)
+	status, archived, err := s.receptionRepo.GetPvzStatus(reception.PvzId)
 	if err != nil {
-		log.Printf("error start transaction: %v", err)
-
 		return nil, err
 	}
-	defer tx.Rollback()
+
+	if archived {
+		return nil, PvzArchived
+	}
+
+	if status != entity.PvzStatusActive {
+		return nil, PvzNotActive
+	}
 
 	id, err := s.receptionRepo.GetOpenedReceptionId(reception.PvzId)
 	if err != nil {
@@ -62,8 +110,6 @@ func (s *ReceptionService) CreateReception(reception *entity.Reception) (*entity
 		return nil, err
 	}
 
-	tx.Commit()
-
 	metrics.CreateReception()
 
 	return reception, nil
@@ -72,14 +118,84 @@ func (s *ReceptionService) CreateReception(reception *entity.Reception) (*entity
 func (s *ReceptionService) CreateProduct(product *entity.Product, pvzID uuid.UUID) (*entity.Product, error) {
 	log.SetPrefix("ReceptionService.CreateProduct")
 
-	tx, err := s.db.BeginTx(context.Background(), nil)
+	if product.Barcode != nil && !entity.IsValidBarcode(*product.Barcode) {
+		return nil, InvalidBarcode
+	}
+
+	if !product.HasValidSize() {
+		return nil, InvalidProductSize
+	}
+
+	id, err := s.receptionRepo.GetOpenedReceptionId(pvzID)
+	if err != nil {
+		return nil, err
+	}
+
+	if id == uuid.Nil {
+		return nil, ReceptionNotOpened
+	}
+
+	productTypes, err := s.getActiveProductTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := productTypes[product.Type]; !ok {
+		return nil, InvalidProductType
+	}
+
+	capacity, err := s.receptionRepo.GetRemainingProductCapacity(id)
 	if err != nil {
-		log.Printf("error start transaction: %v", err)
+		return nil, err
+	}
+
+	if capacity != nil && *capacity <= 0 {
+		return nil, ProductLimitReached
+	}
+
+	if product.Barcode != nil {
+		scanned, err := s.receptionRepo.FindScannedBarcodes(id, []string{*product.Barcode})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(scanned) > 0 {
+			return nil, DuplicateScan
+		}
+	}
 
+	if volume := product.VolumeCm3(); volume > 0 {
+		usage, err := s.receptionRepo.GetStorageUsage(id)
+		if err != nil {
+			return nil, err
+		}
+
+		if usage.Exceeds(volume) {
+			if usage.LimitMode != entity.StorageLimitModeWarn {
+				return nil, StorageLimitReached
+			}
+
+			product.StorageLimitExceeded = true
+		}
+	}
+
+	product.ReceptionId = id
+
+	product, err = s.receptionRepo.CreateProduct(product)
+	if err != nil {
 		return nil, err
 	}
 
-	defer tx.Rollback()
+	metrics.AddProduct()
+
+	return product, nil
+}
+
+// CreateProducts добавляет пакет товаров в открытую приемку ПВЗ.
+// В режиме atomic ошибка любого товара отклоняет весь пакет с ProductBatchRejected,
+// в режиме partial добавляются только корректные товары.
+func (s *ReceptionService) CreateProducts(products []*entity.Product, pvzID uuid.UUID, mode string) ([]entity.ProductBatchResult, error) {
+	log.SetPrefix("ReceptionService.CreateProducts")
 
 	id, err := s.receptionRepo.GetOpenedReceptionId(pvzID)
 	if err != nil {
@@ -90,79 +206,418 @@ func (s *ReceptionService) CreateProduct(product *entity.Product, pvzID uuid.UUI
 		return nil, ReceptionNotOpened
 	}
 
-	product.ReceptionId = id
+	productTypes, err := s.getActiveProductTypes()
+	if err != nil {
+		return nil, err
+	}
 
-	product, err = s.receptionRepo.CreateProduct(product)
+	capacity, err := s.receptionRepo.GetRemainingProductCapacity(id)
 	if err != nil {
 		return nil, err
 	}
 
-	tx.Commit()
+	scanned, err := s.findScannedBarcodes(id, products)
+	if err != nil {
+		return nil, err
+	}
 
-	metrics.AddProduct()
+	usage, err := s.getStorageUsage(id, products)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]entity.ProductBatchResult, len(products))
+	accepted := make([]*entity.Product, 0, len(products))
+	acceptedIdx := make([]int, 0, len(products))
+	failed := false
+
+	for i, product := range products {
+		_, validType := productTypes[product.Type]
+		_, duplicate := scanned[barcodeOf(product)]
+
+		switch {
+		case !validType:
+			results[i].Err = InvalidProductType
+		case product.Barcode != nil && !entity.IsValidBarcode(*product.Barcode):
+			results[i].Err = InvalidBarcode
+		case product.Barcode != nil && duplicate:
+			results[i].Err = DuplicateScan
+		case !product.HasValidSize():
+			results[i].Err = InvalidProductSize
+		case capacity != nil && len(accepted) >= *capacity:
+			results[i].Err = ProductLimitReached
+		case usage.Exceeds(product.VolumeCm3()) && usage.LimitMode != entity.StorageLimitModeWarn:
+			results[i].Err = StorageLimitReached
+		default:
+			product.StorageLimitExceeded = usage.Exceeds(product.VolumeCm3())
+			usage.UsedVolumeCm3 += product.VolumeCm3()
+			product.ReceptionId = id
+			results[i].Product = product
+			accepted = append(accepted, product)
+			acceptedIdx = append(acceptedIdx, i)
+			if product.Barcode != nil {
+				scanned[*product.Barcode] = struct{}{}
+			}
+			continue
+		}
+
+		failed = true
+	}
+
+	if failed && mode != entity.ProductBatchModePartial {
+		for i := range results {
+			results[i].Product = nil
+		}
+
+		return results, ProductBatchRejected
+	}
+
+	// Репозиторий повторяет проверки лимита и дублей под блокировкой приемки: в режиме
+	// partial товары, добавленные параллельно, отклоняются поштучно, а не всем пакетом.
+	rejected, err := s.receptionRepo.CreateProducts(accepted, mode == entity.ProductBatchModePartial)
+	if err != nil {
+		return nil, err
+	}
+
+	for j, i := range acceptedIdx {
+		if rejected[j] != nil {
+			results[i].Product = nil
+			results[i].Err = rejected[j]
+			continue
+		}
+
+		metrics.AddProduct()
+	}
+
+	return results, nil
+}
+
+func (s *ReceptionService) getActiveProductTypes() (map[string]struct{}, error) {
+	names, err := s.receptionRepo.GetActiveProductTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	productTypes := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		productTypes[name] = struct{}{}
+	}
+
+	return productTypes, nil
+}
+
+// findScannedBarcodes возвращает штрихкоды пакета, уже отсканированные в приемке.
+func (s *ReceptionService) findScannedBarcodes(receptionID uuid.UUID, products []*entity.Product) (map[string]struct{}, error) {
+	barcodes := make([]string, 0, len(products))
+	for _, product := range products {
+		if product.Barcode != nil {
+			barcodes = append(barcodes, *product.Barcode)
+		}
+	}
+
+	scanned := make(map[string]struct{})
+	if len(barcodes) == 0 {
+		return scanned, nil
+	}
+
+	found, err := s.receptionRepo.FindScannedBarcodes(receptionID, barcodes)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, barcode := range found {
+		scanned[barcode] = struct{}{}
+	}
+
+	return scanned, nil
+}
+
+// getStorageUsage возвращает занятый объем ПВЗ. Если в пакете нет товаров с габаритами,
+// лимит объема не проверяется и запрос к базе не выполняется.
+func (s *ReceptionService) getStorageUsage(receptionID uuid.UUID, products []*entity.Product) (*entity.StorageUsage, error) {
+	for _, product := range products {
+		if product.VolumeCm3() > 0 {
+			return s.receptionRepo.GetStorageUsage(receptionID)
+		}
+	}
+
+	return &entity.StorageUsage{}, nil
+}
+
+func barcodeOf(product *entity.Product) string {
+	if product.Barcode == nil {
+		return ""
+	}
+
+	return *product.Barcode
+}
+
+func (s *ReceptionService) GetProductsByBarcode(barcode string) ([]*entity.Product, error) {
+	if !entity.IsValidBarcode(barcode) {
+		return nil, InvalidBarcode
+	}
+
+	return s.receptionRepo.GetProductsByBarcode(barcode)
+}
+
+// ChangeProductStatus переводит товар в статус status по жизненному циклу
+// accepted → stored → issued | returned_to_sender. На хранение передаются только товары закрытых приемок.
+func (s *ReceptionService) ChangeProductStatus(productID uuid.UUID, status string) (*entity.Product, error) {
+	log.SetPrefix("ReceptionService.ChangeProductStatus")
+
+	product, err := s.receptionRepo.GetProduct(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !entity.CanChangeProductStatus(product.Status, status) {
+		return nil, ProductStatusConflict
+	}
+
+	if product.ShipmentId != nil {
+		return nil, ProductInShipment
+	}
+
+	if status == entity.ProductStatusStored {
+		reception, err := s.receptionRepo.GetReception(product.ReceptionId)
+		if err != nil {
+			return nil, err
+		}
+
+		if reception.Status != entity.ReceptionStatusClosed {
+			return nil, ReceptionNotClosed
+		}
+	}
+
+	product, err = s.receptionRepo.UpdateProductStatus(productID, product.Status, status, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	// Статус успел изменить параллельный запрос.
+	if product == nil {
+		return nil, ProductStatusConflict
+	}
+
+	metrics.ChangeProductStatus(status)
 
 	return product, nil
 }
 
+// GetProductsOnHand возвращает товары, находящиеся в ПВЗ. Пустой status означает принятые и хранящиеся товары.
+func (s *ReceptionService) GetProductsOnHand(pvzID uuid.UUID, status string) ([]*entity.Product, error) {
+	if status == "" {
+		return s.receptionRepo.GetProductsOnHand(pvzID, nil)
+	}
+
+	if !entity.IsOnHand(status) {
+		return nil, InvalidProductStatus
+	}
+
+	return s.receptionRepo.GetProductsOnHand(pvzID, []string{status})
+}
+
 func (s *ReceptionService) DeleteLastProduct(pvzID uuid.UUID) error {
 	log.SetPrefix("ReceptionService.DeleteLastProduct")
 
-	tx, err := s.db.BeginTx(context.Background(), nil)
+	id, err := s.receptionRepo.GetOpenedReceptionId(pvzID)
 	if err != nil {
-		log.Printf("error start transaction: %v", err)
+		return err
+	}
+
+	if id == uuid.Nil {
+		return ReceptionNotOpened
+	}
 
+	deleted, err := s.receptionRepo.DeleteLastProduct(id)
+	if err != nil {
 		return err
 	}
 
-	defer tx.Rollback()
+	if !deleted {
+		return ProductNotFound
+	}
 
-	id, err := s.receptionRepo.GetOpenedReceptionId(pvzID)
+	metrics.DeleteProduct()
+
+	return nil
+}
+
+func (s *ReceptionService) DeleteProduct(receptionID, productID uuid.UUID) error {
+	log.SetPrefix("ReceptionService.DeleteProduct")
+
+	reception, err := s.receptionRepo.GetReception(receptionID)
 	if err != nil {
 		return err
 	}
 
-	if id == uuid.Nil {
+	if reception.Status != entity.ReceptionStatusInProgress {
 		return ReceptionNotOpened
 	}
 
-	if err = s.receptionRepo.DeleteLastProduct(id); err != nil {
+	deleted, err := s.receptionRepo.DeleteProduct(receptionID, productID)
+	if err != nil {
 		return err
 	}
 
-	tx.Commit()
+	if !deleted {
+		return ProductNotFound
+	}
 
 	metrics.DeleteProduct()
 
 	return nil
 }
 
-func (s *ReceptionService) CloseLastReception(pvzID uuid.UUID) (*entity.Reception, error) {
+// CloseLastReception закрывает открытую приемку ПВЗ. versions - допустимые версии приемки
+// из If-Match, nil не проверяется.
+func (s *ReceptionService) CloseLastReception(pvzID uuid.UUID, versions []int64) (*entity.Reception, error) {
 	log.SetPrefix("ReceptionService.CloseLastReception")
 
-	tx, err := s.db.BeginTx(context.Background(), nil)
+	id, err := s.receptionRepo.GetOpenedReceptionId(pvzID)
 	if err != nil {
-		log.Printf("error start transaction: %v", err)
+		return nil, err
+	}
+
+	if id == uuid.Nil {
+		return nil, ReceptionAlreadyClosed
+	}
 
+	reception, err := s.receptionRepo.GetReception(id)
+	if err != nil {
 		return nil, err
 	}
 
-	defer tx.Rollback()
+	if !versionMatches(reception, versions) {
+		return nil, VersionMismatch
+	}
 
-	id, err := s.receptionRepo.GetOpenedReceptionId(pvzID)
+	reception, err = s.receptionRepo.CloseLastReception(id, reception.Version)
 	if err != nil {
 		return nil, err
 	}
 
-	if id == uuid.Nil {
-		return nil, ReceptionAlreadyClosed
+	if reception == nil {
+		return nil, VersionMismatch
+	}
+
+	return reception, nil
+}
+
+// ReopenReception снова открывает последнюю приемку ПВЗ, закрытую не раньше reopenWindow назад.
+// Условия повторяются в UPDATE: если их нарушил параллельный запрос, причина определяется заново.
+func (s *ReceptionService) ReopenReception(receptionID uuid.UUID, versions []int64) (*entity.Reception, error) {
+	log.SetPrefix("ReceptionService.ReopenReception")
+
+	reception, err := s.receptionRepo.GetReception(receptionID)
+	if err != nil {
+		return nil, err
 	}
 
-	reception, err := s.receptionRepo.CloseLastReception(id)
+	if !versionMatches(reception, versions) {
+		return nil, VersionMismatch
+	}
+
+	closedAfter := time.Now().UTC().Add(-s.reopenWindow)
+	if err = s.checkReopen(reception, closedAfter); err != nil {
+		return nil, err
+	}
+
+	reopened, err := s.receptionRepo.ReopenReception(receptionID, reception.Version, closedAfter)
 	if err != nil {
 		return nil, err
 	}
 
-	tx.Commit()
+	if reopened == nil {
+		reception, err = s.receptionRepo.GetReception(receptionID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = s.checkReopen(reception, closedAfter); err != nil {
+			return nil, err
+		}
+
+		return nil, VersionMismatch
+	}
+
+	return reopened, nil
+}
+
+func (s *ReceptionService) checkReopen(reception *entity.Reception, closedAfter time.Time) error {
+	if reception.Status != entity.ReceptionStatusClosed {
+		return ReceptionNotClosed
+	}
+
+	if reception.ClosedAt == nil || reception.ClosedAt.Before(closedAfter) {
+		return ReopenWindowExpired
+	}
+
+	id, err := s.receptionRepo.GetOpenedReceptionId(reception.PvzId)
+	if err != nil {
+		return err
+	}
+
+	if id != uuid.Nil {
+		return ReceptionAlreadyOpened
+	}
+
+	newer, err := s.receptionRepo.HasNewerReception(reception.PvzId, reception.DateTime)
+	if err != nil {
+		return err
+	}
+
+	if newer {
+		return ReceptionNotLatest
+	}
+
+	return nil
+}
+
+func (s *ReceptionService) CancelReception(receptionID uuid.UUID, reason string, versions []int64) (*entity.Reception, error) {
+	log.SetPrefix("ReceptionService.CancelReception")
+
+	reception, err := s.receptionRepo.GetReception(receptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !versionMatches(reception, versions) {
+		return nil, VersionMismatch
+	}
+
+	if reception.Status != entity.ReceptionStatusInProgress {
+		return nil, ReceptionNotOpened
+	}
+
+	reception, err = s.receptionRepo.CancelReception(receptionID, reason, reception.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if reception == nil {
+		return nil, VersionMismatch
+	}
 
 	return reception, nil
 }
+
+// CloseStaleReceptions закрывает приемки, превысившие допустимое для ПВЗ время.
+func (s *ReceptionService) CloseStaleReceptions() (int64, error) {
+	log.SetPrefix("ReceptionService.CloseStaleReceptions")
+
+	closed, err := s.receptionRepo.CloseStaleReceptions(time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+
+	if closed > 0 {
+		log.Printf("auto-closed %d receptions", closed)
+	}
+
+	return closed, nil
+}
+
+func versionMatches(reception *entity.Reception, versions []int64) bool {
+	return versions == nil || slices.Contains(versions, reception.Version)
+}
@@ -3,8 +3,8 @@ package service_test
 import (
 	"errors"
 	"testing"
+	"time"
 
-	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
 	"github.com/alexey-shedrin/avito-test-task/internal/repository/mocks"
 	"github.com/alexey-shedrin/avito-test-task/internal/service"
@@ -13,17 +13,16 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+var activeProductTypes = []string{"Test Product", entity.ProductTypeElectronics, entity.ProductTypeClothes, entity.ProductTypeShoes}
+
 func TestReceptionService_CreateReception(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		mockRepo := mocks.NewMockReceptionRepository(ctrl)
-		db, mock, err := sqlmock.New()
-		require.NoError(t, err)
-		defer db.Close()
 
-		receptionSvc := service.NewReceptionService(mockRepo, db)
+		receptionSvc := service.NewReceptionService(mockRepo)
 
 		pvzID := uuid.New()
 		expectedReception := &entity.Reception{
@@ -34,16 +33,14 @@ func TestReceptionService_CreateReception(t *testing.T) {
 			PvzId: pvzID,
 		}
 
-		mock.ExpectBegin()
+		mockRepo.EXPECT().GetPvzStatus(pvzID).Return(entity.PvzStatusActive, false, nil)
 		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(uuid.Nil, nil)
 		mockRepo.EXPECT().CreateReception(expectedReception).Return(returnedReception, nil)
-		mock.ExpectCommit()
 
 		result, err := receptionSvc.CreateReception(expectedReception)
 
 		require.NoError(t, err)
 		require.Equal(t, returnedReception, result)
-		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
 	t.Run("Reception already opened", func(t *testing.T) {
@@ -51,11 +48,8 @@ func TestReceptionService_CreateReception(t *testing.T) {
 		defer ctrl.Finish()
 
 		mockRepo := mocks.NewMockReceptionRepository(ctrl)
-		db, mock, err := sqlmock.New()
-		require.NoError(t, err)
-		defer db.Close()
 
-		receptionSvc := service.NewReceptionService(mockRepo, db)
+		receptionSvc := service.NewReceptionService(mockRepo)
 
 		pvzID := uuid.New()
 		openedReceptionID := uuid.New()
@@ -63,54 +57,47 @@ func TestReceptionService_CreateReception(t *testing.T) {
 			PvzId: pvzID,
 		}
 
-		mock.ExpectBegin()
+		mockRepo.EXPECT().GetPvzStatus(pvzID).Return(entity.PvzStatusActive, false, nil)
 		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(openedReceptionID, nil)
-		mock.ExpectRollback()
 
 		result, err := receptionSvc.CreateReception(reception)
 
 		require.Error(t, err)
 		require.Equal(t, service.ReceptionAlreadyOpened, err)
 		require.Nil(t, result)
-		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
-	t.Run("Transaction begin error", func(t *testing.T) {
+	t.Run("GetOpenedReceptionId error", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		mockRepo := mocks.NewMockReceptionRepository(ctrl)
-		db, mock, err := sqlmock.New()
-		require.NoError(t, err)
-		defer db.Close()
-
-		receptionSvc := service.NewReceptionService(mockRepo, db)
 
-		expectedError := errors.New("db error")
-		mock.ExpectBegin().WillReturnError(expectedError)
+		receptionSvc := service.NewReceptionService(mockRepo)
 
+		expectedError := errors.New("repo error")
+		pvzID := uuid.New()
 		reception := &entity.Reception{
-			PvzId: uuid.New(),
+			PvzId: pvzID,
 		}
 
+		mockRepo.EXPECT().GetPvzStatus(pvzID).Return(entity.PvzStatusActive, false, nil)
+		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(uuid.Nil, expectedError)
+
 		result, err := receptionSvc.CreateReception(reception)
 
 		require.Error(t, err)
 		require.Equal(t, expectedError, err)
 		require.Nil(t, result)
-		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
-	t.Run("GetOpenedReceptionId error", func(t *testing.T) {
+	t.Run("CreateReception error", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		mockRepo := mocks.NewMockReceptionRepository(ctrl)
-		db, mock, err := sqlmock.New()
-		require.NoError(t, err)
-		defer db.Close()
 
-		receptionSvc := service.NewReceptionService(mockRepo, db)
+		receptionSvc := service.NewReceptionService(mockRepo)
 
 		expectedError := errors.New("repo error")
 		pvzID := uuid.New()
@@ -118,46 +105,51 @@ func TestReceptionService_CreateReception(t *testing.T) {
 			PvzId: pvzID,
 		}
 
-		mock.ExpectBegin()
-		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(uuid.Nil, expectedError)
-		mock.ExpectRollback()
+		mockRepo.EXPECT().GetPvzStatus(pvzID).Return(entity.PvzStatusActive, false, nil)
+		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(uuid.Nil, nil)
+		mockRepo.EXPECT().CreateReception(reception).Return(nil, expectedError)
 
 		result, err := receptionSvc.CreateReception(reception)
 
 		require.Error(t, err)
 		require.Equal(t, expectedError, err)
 		require.Nil(t, result)
-		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
-	t.Run("CreateReception error", func(t *testing.T) {
+	t.Run("PVZ archived", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		mockRepo := mocks.NewMockReceptionRepository(ctrl)
-		db, mock, err := sqlmock.New()
-		require.NoError(t, err)
-		defer db.Close()
 
-		receptionSvc := service.NewReceptionService(mockRepo, db)
+		receptionSvc := service.NewReceptionService(mockRepo)
 
-		expectedError := errors.New("repo error")
 		pvzID := uuid.New()
-		reception := &entity.Reception{
-			PvzId: pvzID,
-		}
 
-		mock.ExpectBegin()
-		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(uuid.Nil, nil)
-		mockRepo.EXPECT().CreateReception(reception).Return(nil, expectedError)
-		mock.ExpectRollback()
+		mockRepo.EXPECT().GetPvzStatus(pvzID).Return(entity.PvzStatusActive, true, nil)
 
-		result, err := receptionSvc.CreateReception(reception)
+		result, err := receptionSvc.CreateReception(&entity.Reception{PvzId: pvzID})
 
-		require.Error(t, err)
-		require.Equal(t, expectedError, err)
+		require.Equal(t, service.PvzArchived, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("PVZ suspended", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		pvzID := uuid.New()
+
+		mockRepo.EXPECT().GetPvzStatus(pvzID).Return(entity.PvzStatusSuspended, false, nil)
+
+		result, err := receptionSvc.CreateReception(&entity.Reception{PvzId: pvzID})
+
+		require.Equal(t, service.PvzNotActive, err)
 		require.Nil(t, result)
-		require.NoError(t, mock.ExpectationsWereMet())
 	})
 }
 
@@ -167,11 +159,8 @@ func TestReceptionService_CreateProduct(t *testing.T) {
 		defer ctrl.Finish()
 
 		mockRepo := mocks.NewMockReceptionRepository(ctrl)
-		db, mock, err := sqlmock.New()
-		require.NoError(t, err)
-		defer db.Close()
 
-		receptionSvc := service.NewReceptionService(mockRepo, db)
+		receptionSvc := service.NewReceptionService(mockRepo)
 
 		pvzID := uuid.New()
 		receptionID := uuid.New()
@@ -188,16 +177,15 @@ func TestReceptionService_CreateProduct(t *testing.T) {
 			ReceptionId: receptionID,
 		}
 
-		mock.ExpectBegin()
 		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(receptionID, nil)
+		mockRepo.EXPECT().GetActiveProductTypes().Return(activeProductTypes, nil)
+		mockRepo.EXPECT().GetRemainingProductCapacity(receptionID).Return(nil, nil)
 		mockRepo.EXPECT().CreateProduct(expectedProduct).Return(returnedProduct, nil)
-		mock.ExpectCommit()
 
 		result, err := receptionSvc.CreateProduct(product, pvzID)
 
 		require.NoError(t, err)
 		require.Equal(t, returnedProduct, result)
-		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
 	t.Run("Reception not opened", func(t *testing.T) {
@@ -205,53 +193,61 @@ func TestReceptionService_CreateProduct(t *testing.T) {
 		defer ctrl.Finish()
 
 		mockRepo := mocks.NewMockReceptionRepository(ctrl)
-		db, mock, err := sqlmock.New()
-		require.NoError(t, err)
-		defer db.Close()
 
-		receptionSvc := service.NewReceptionService(mockRepo, db)
+		receptionSvc := service.NewReceptionService(mockRepo)
 
 		pvzID := uuid.New()
 		product := &entity.Product{
 			Type: "Test Product",
 		}
 
-		mock.ExpectBegin()
 		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(uuid.Nil, nil)
-		mock.ExpectRollback()
 
 		result, err := receptionSvc.CreateProduct(product, pvzID)
 
 		require.Error(t, err)
 		require.Equal(t, service.ReceptionNotOpened, err)
 		require.Nil(t, result)
-		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
-	t.Run("Transaction begin error", func(t *testing.T) {
+	t.Run("Product limit reached", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		mockRepo := mocks.NewMockReceptionRepository(ctrl)
-		db, mock, err := sqlmock.New()
-		require.NoError(t, err)
-		defer db.Close()
 
-		receptionSvc := service.NewReceptionService(mockRepo, db)
+		receptionSvc := service.NewReceptionService(mockRepo)
 
-		expectedError := errors.New("db error")
-		mock.ExpectBegin().WillReturnError(expectedError)
+		pvzID := uuid.New()
+		receptionID := uuid.New()
 
-		product := &entity.Product{
-			Type: "Test Product",
-		}
+		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(receptionID, nil)
+		mockRepo.EXPECT().GetActiveProductTypes().Return(activeProductTypes, nil)
+		mockRepo.EXPECT().GetRemainingProductCapacity(receptionID).Return(new(int), nil)
 
-		result, err := receptionSvc.CreateProduct(product, uuid.New())
+		result, err := receptionSvc.CreateProduct(&entity.Product{Type: "Test Product"}, pvzID)
 
-		require.Error(t, err)
-		require.Equal(t, expectedError, err)
+		require.Equal(t, service.ProductLimitReached, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Deprecated product type", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		pvzID := uuid.New()
+
+		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(uuid.New(), nil)
+		mockRepo.EXPECT().GetActiveProductTypes().Return([]string{entity.ProductTypeShoes}, nil)
+
+		result, err := receptionSvc.CreateProduct(&entity.Product{Type: entity.ProductTypeClothes}, pvzID)
+
+		require.Equal(t, service.InvalidProductType, err)
 		require.Nil(t, result)
-		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
 	t.Run("GetOpenedReceptionId error", func(t *testing.T) {
@@ -259,11 +255,8 @@ func TestReceptionService_CreateProduct(t *testing.T) {
 		defer ctrl.Finish()
 
 		mockRepo := mocks.NewMockReceptionRepository(ctrl)
-		db, mock, err := sqlmock.New()
-		require.NoError(t, err)
-		defer db.Close()
 
-		receptionSvc := service.NewReceptionService(mockRepo, db)
+		receptionSvc := service.NewReceptionService(mockRepo)
 
 		pvzID := uuid.New()
 		expectedError := errors.New("repo error")
@@ -271,16 +264,13 @@ func TestReceptionService_CreateProduct(t *testing.T) {
 			Type: "Test Product",
 		}
 
-		mock.ExpectBegin()
 		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(uuid.Nil, expectedError)
-		mock.ExpectRollback()
 
 		result, err := receptionSvc.CreateProduct(product, pvzID)
 
 		require.Error(t, err)
 		require.Equal(t, expectedError, err)
 		require.Nil(t, result)
-		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
 	t.Run("CreateProduct error", func(t *testing.T) {
@@ -288,11 +278,8 @@ func TestReceptionService_CreateProduct(t *testing.T) {
 		defer ctrl.Finish()
 
 		mockRepo := mocks.NewMockReceptionRepository(ctrl)
-		db, mock, err := sqlmock.New()
-		require.NoError(t, err)
-		defer db.Close()
 
-		receptionSvc := service.NewReceptionService(mockRepo, db)
+		receptionSvc := service.NewReceptionService(mockRepo)
 
 		expectedError := errors.New("repo error")
 		pvzID := uuid.New()
@@ -305,17 +292,16 @@ func TestReceptionService_CreateProduct(t *testing.T) {
 			ReceptionId: receptionID,
 		}
 
-		mock.ExpectBegin()
 		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(receptionID, nil)
+		mockRepo.EXPECT().GetActiveProductTypes().Return(activeProductTypes, nil)
+		mockRepo.EXPECT().GetRemainingProductCapacity(receptionID).Return(nil, nil)
 		mockRepo.EXPECT().CreateProduct(expectedProduct).Return(nil, expectedError)
-		mock.ExpectRollback()
 
 		result, err := receptionSvc.CreateProduct(product, pvzID)
 
 		require.Error(t, err)
 		require.Equal(t, expectedError, err)
 		require.Nil(t, result)
-		require.NoError(t, mock.ExpectationsWereMet())
 	})
 }
 
@@ -325,69 +311,55 @@ func TestReceptionService_DeleteLastProduct(t *testing.T) {
 		defer ctrl.Finish()
 
 		mockRepo := mocks.NewMockReceptionRepository(ctrl)
-		db, mock, err := sqlmock.New()
-		require.NoError(t, err)
-		defer db.Close()
 
-		receptionSvc := service.NewReceptionService(mockRepo, db)
+		receptionSvc := service.NewReceptionService(mockRepo)
 
 		pvzID := uuid.New()
 		receptionID := uuid.New()
 
-		mock.ExpectBegin()
 		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(receptionID, nil)
-		mockRepo.EXPECT().DeleteLastProduct(receptionID).Return(nil)
-		mock.ExpectCommit()
+		mockRepo.EXPECT().DeleteLastProduct(receptionID).Return(true, nil)
 
-		err = receptionSvc.DeleteLastProduct(pvzID)
+		err := receptionSvc.DeleteLastProduct(pvzID)
 
 		require.NoError(t, err)
-		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
-	t.Run("Reception not opened", func(t *testing.T) {
+	t.Run("Empty reception", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		mockRepo := mocks.NewMockReceptionRepository(ctrl)
-		db, mock, err := sqlmock.New()
-		require.NoError(t, err)
-		defer db.Close()
 
-		receptionSvc := service.NewReceptionService(mockRepo, db)
+		receptionSvc := service.NewReceptionService(mockRepo)
 
 		pvzID := uuid.New()
+		receptionID := uuid.New()
 
-		mock.ExpectBegin()
-		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(uuid.Nil, nil)
-		mock.ExpectRollback()
+		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(receptionID, nil)
+		mockRepo.EXPECT().DeleteLastProduct(receptionID).Return(false, nil)
 
-		err = receptionSvc.DeleteLastProduct(pvzID)
+		err := receptionSvc.DeleteLastProduct(pvzID)
 
-		require.Error(t, err)
-		require.Equal(t, service.ReceptionNotOpened, err)
-		require.NoError(t, mock.ExpectationsWereMet())
+		require.Equal(t, service.ProductNotFound, err)
 	})
 
-	t.Run("Transaction begin error", func(t *testing.T) {
+	t.Run("Reception not opened", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		mockRepo := mocks.NewMockReceptionRepository(ctrl)
-		db, mock, err := sqlmock.New()
-		require.NoError(t, err)
-		defer db.Close()
 
-		receptionSvc := service.NewReceptionService(mockRepo, db)
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		pvzID := uuid.New()
 
-		expectedError := errors.New("db error")
-		mock.ExpectBegin().WillReturnError(expectedError)
+		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(uuid.Nil, nil)
 
-		err = receptionSvc.DeleteLastProduct(uuid.New())
+		err := receptionSvc.DeleteLastProduct(pvzID)
 
 		require.Error(t, err)
-		require.Equal(t, expectedError, err)
-		require.NoError(t, mock.ExpectationsWereMet())
+		require.Equal(t, service.ReceptionNotOpened, err)
 	})
 
 	t.Run("GetOpenedReceptionId error", func(t *testing.T) {
@@ -395,24 +367,18 @@ func TestReceptionService_DeleteLastProduct(t *testing.T) {
 		defer ctrl.Finish()
 
 		mockRepo := mocks.NewMockReceptionRepository(ctrl)
-		db, mock, err := sqlmock.New()
-		require.NoError(t, err)
-		defer db.Close()
 
-		receptionSvc := service.NewReceptionService(mockRepo, db)
+		receptionSvc := service.NewReceptionService(mockRepo)
 
 		pvzID := uuid.New()
 		expectedError := errors.New("repo error")
 
-		mock.ExpectBegin()
 		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(uuid.Nil, expectedError)
-		mock.ExpectRollback()
 
-		err = receptionSvc.DeleteLastProduct(pvzID)
+		err := receptionSvc.DeleteLastProduct(pvzID)
 
 		require.Error(t, err)
 		require.Equal(t, expectedError, err)
-		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
 	t.Run("DeleteLastProduct error", func(t *testing.T) {
@@ -420,26 +386,20 @@ func TestReceptionService_DeleteLastProduct(t *testing.T) {
 		defer ctrl.Finish()
 
 		mockRepo := mocks.NewMockReceptionRepository(ctrl)
-		db, mock, err := sqlmock.New()
-		require.NoError(t, err)
-		defer db.Close()
 
-		receptionSvc := service.NewReceptionService(mockRepo, db)
+		receptionSvc := service.NewReceptionService(mockRepo)
 
 		pvzID := uuid.New()
 		receptionID := uuid.New()
 		expectedError := errors.New("repo error")
 
-		mock.ExpectBegin()
 		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(receptionID, nil)
-		mockRepo.EXPECT().DeleteLastProduct(receptionID).Return(expectedError)
-		mock.ExpectRollback()
+		mockRepo.EXPECT().DeleteLastProduct(receptionID).Return(false, expectedError)
 
-		err = receptionSvc.DeleteLastProduct(pvzID)
+		err := receptionSvc.DeleteLastProduct(pvzID)
 
 		require.Error(t, err)
 		require.Equal(t, expectedError, err)
-		require.NoError(t, mock.ExpectationsWereMet())
 	})
 }
 
@@ -449,76 +409,87 @@ func TestReceptionService_CloseLastReception(t *testing.T) {
 		defer ctrl.Finish()
 
 		mockRepo := mocks.NewMockReceptionRepository(ctrl)
-		db, mock, err := sqlmock.New()
-		require.NoError(t, err)
-		defer db.Close()
 
-		receptionSvc := service.NewReceptionService(mockRepo, db)
+		receptionSvc := service.NewReceptionService(mockRepo)
 
 		pvzID := uuid.New()
 		receptionID := uuid.New()
+		opened := &entity.Reception{Id: receptionID, PvzId: pvzID, Version: 1}
 		returnedReception := &entity.Reception{
-			Id:    receptionID,
-			PvzId: pvzID,
+			Id:      receptionID,
+			PvzId:   pvzID,
+			Version: 2,
 		}
 
-		mock.ExpectBegin()
 		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(receptionID, nil)
-		mockRepo.EXPECT().CloseLastReception(receptionID).Return(returnedReception, nil)
-		mock.ExpectCommit()
+		mockRepo.EXPECT().GetReception(receptionID).Return(opened, nil)
+		mockRepo.EXPECT().CloseLastReception(receptionID, int64(1)).Return(returnedReception, nil)
 
-		result, err := receptionSvc.CloseLastReception(pvzID)
+		result, err := receptionSvc.CloseLastReception(pvzID, nil)
 
 		require.NoError(t, err)
 		require.Equal(t, returnedReception, result)
-		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
-	t.Run("Reception already closed", func(t *testing.T) {
+	t.Run("Stale If-Match", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		mockRepo := mocks.NewMockReceptionRepository(ctrl)
-		db, mock, err := sqlmock.New()
-		require.NoError(t, err)
-		defer db.Close()
 
-		receptionSvc := service.NewReceptionService(mockRepo, db)
+		receptionSvc := service.NewReceptionService(mockRepo)
 
 		pvzID := uuid.New()
+		receptionID := uuid.New()
+		versions := []int64{1}
 
-		mock.ExpectBegin()
-		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(uuid.Nil, nil)
-		mock.ExpectRollback()
+		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(receptionID, nil)
+		mockRepo.EXPECT().GetReception(receptionID).Return(&entity.Reception{Id: receptionID, PvzId: pvzID, Version: 2}, nil)
 
-		result, err := receptionSvc.CloseLastReception(pvzID)
+		result, err := receptionSvc.CloseLastReception(pvzID, versions)
 
-		require.Error(t, err)
-		require.Equal(t, service.ReceptionAlreadyClosed, err)
+		require.Equal(t, service.VersionMismatch, err)
 		require.Nil(t, result)
-		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
-	t.Run("Transaction begin error", func(t *testing.T) {
+	t.Run("Concurrent close", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
 		mockRepo := mocks.NewMockReceptionRepository(ctrl)
-		db, mock, err := sqlmock.New()
-		require.NoError(t, err)
-		defer db.Close()
 
-		receptionSvc := service.NewReceptionService(mockRepo, db)
+		receptionSvc := service.NewReceptionService(mockRepo)
 
-		expectedError := errors.New("db error")
-		mock.ExpectBegin().WillReturnError(expectedError)
+		pvzID := uuid.New()
+		receptionID := uuid.New()
 
-		result, err := receptionSvc.CloseLastReception(uuid.New())
+		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(receptionID, nil)
+		mockRepo.EXPECT().GetReception(receptionID).Return(&entity.Reception{Id: receptionID, PvzId: pvzID, Version: 1}, nil)
+		mockRepo.EXPECT().CloseLastReception(receptionID, int64(1)).Return(nil, nil)
+
+		result, err := receptionSvc.CloseLastReception(pvzID, nil)
+
+		require.Equal(t, service.VersionMismatch, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Reception already closed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		pvzID := uuid.New()
+
+		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(uuid.Nil, nil)
+
+		result, err := receptionSvc.CloseLastReception(pvzID, nil)
 
 		require.Error(t, err)
-		require.Equal(t, expectedError, err)
+		require.Equal(t, service.ReceptionAlreadyClosed, err)
 		require.Nil(t, result)
-		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
 	t.Run("GetOpenedReceptionId error", func(t *testing.T) {
@@ -526,25 +497,19 @@ func TestReceptionService_CloseLastReception(t *testing.T) {
 		defer ctrl.Finish()
 
 		mockRepo := mocks.NewMockReceptionRepository(ctrl)
-		db, mock, err := sqlmock.New()
-		require.NoError(t, err)
-		defer db.Close()
 
-		receptionSvc := service.NewReceptionService(mockRepo, db)
+		receptionSvc := service.NewReceptionService(mockRepo)
 
 		pvzID := uuid.New()
 		expectedError := errors.New("repo error")
 
-		mock.ExpectBegin()
 		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(uuid.Nil, expectedError)
-		mock.ExpectRollback()
 
-		result, err := receptionSvc.CloseLastReception(pvzID)
+		result, err := receptionSvc.CloseLastReception(pvzID, nil)
 
 		require.Error(t, err)
 		require.Equal(t, expectedError, err)
 		require.Nil(t, result)
-		require.NoError(t, mock.ExpectationsWereMet())
 	})
 
 	t.Run("CloseLastReception error", func(t *testing.T) {
@@ -552,26 +517,643 @@ func TestReceptionService_CloseLastReception(t *testing.T) {
 		defer ctrl.Finish()
 
 		mockRepo := mocks.NewMockReceptionRepository(ctrl)
-		db, mock, err := sqlmock.New()
-		require.NoError(t, err)
-		defer db.Close()
 
-		receptionSvc := service.NewReceptionService(mockRepo, db)
+		receptionSvc := service.NewReceptionService(mockRepo)
 
 		pvzID := uuid.New()
 		receptionID := uuid.New()
 		expectedError := errors.New("repo error")
 
-		mock.ExpectBegin()
 		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(receptionID, nil)
-		mockRepo.EXPECT().CloseLastReception(receptionID).Return(nil, expectedError)
-		mock.ExpectRollback()
+		mockRepo.EXPECT().GetReception(receptionID).Return(&entity.Reception{Id: receptionID, PvzId: pvzID, Version: 1}, nil)
+		mockRepo.EXPECT().CloseLastReception(receptionID, int64(1)).Return(nil, expectedError)
 
-		result, err := receptionSvc.CloseLastReception(pvzID)
+		result, err := receptionSvc.CloseLastReception(pvzID, nil)
 
 		require.Error(t, err)
 		require.Equal(t, expectedError, err)
 		require.Nil(t, result)
-		require.NoError(t, mock.ExpectationsWereMet())
 	})
 }
+
+func TestReceptionService_ReopenReception(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		closedAt := time.Now()
+		closed := &entity.Reception{
+			Id:       uuid.New(),
+			PvzId:    uuid.New(),
+			Status:   entity.ReceptionStatusClosed,
+			DateTime: time.Now().Add(-time.Hour),
+			ClosedAt: &closedAt,
+		}
+		reopened := &entity.Reception{Id: closed.Id, PvzId: closed.PvzId, Status: entity.ReceptionStatusInProgress}
+
+		mockRepo.EXPECT().GetReception(closed.Id).Return(closed, nil)
+		mockRepo.EXPECT().GetOpenedReceptionId(closed.PvzId).Return(uuid.Nil, nil)
+		mockRepo.EXPECT().HasNewerReception(closed.PvzId, closed.DateTime).Return(false, nil)
+		mockRepo.EXPECT().ReopenReception(closed.Id, closed.Version, gomock.Any()).Return(reopened, nil)
+
+		result, err := receptionSvc.ReopenReception(closed.Id, nil)
+
+		require.NoError(t, err)
+		require.Equal(t, reopened, result)
+	})
+
+	t.Run("Newer reception exists", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		closedAt := time.Now()
+		closed := &entity.Reception{
+			Id:       uuid.New(),
+			PvzId:    uuid.New(),
+			Status:   entity.ReceptionStatusClosed,
+			DateTime: time.Now().Add(-time.Hour),
+			ClosedAt: &closedAt,
+		}
+
+		mockRepo.EXPECT().GetReception(closed.Id).Return(closed, nil)
+		mockRepo.EXPECT().GetOpenedReceptionId(closed.PvzId).Return(uuid.Nil, nil)
+		mockRepo.EXPECT().HasNewerReception(closed.PvzId, closed.DateTime).Return(true, nil)
+
+		result, err := receptionSvc.ReopenReception(closed.Id, nil)
+
+		require.Equal(t, service.ReceptionNotLatest, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Reopen window expired", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo).WithReopenWindow(time.Hour)
+
+		closedAt := time.Now().Add(-2 * time.Hour)
+		closed := &entity.Reception{Id: uuid.New(), Status: entity.ReceptionStatusClosed, ClosedAt: &closedAt}
+
+		mockRepo.EXPECT().GetReception(closed.Id).Return(closed, nil)
+
+		result, err := receptionSvc.ReopenReception(closed.Id, nil)
+
+		require.Equal(t, service.ReopenWindowExpired, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Newer reception created concurrently", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		closedAt := time.Now()
+		closed := &entity.Reception{
+			Id:       uuid.New(),
+			PvzId:    uuid.New(),
+			Status:   entity.ReceptionStatusClosed,
+			DateTime: time.Now().Add(-time.Hour),
+			ClosedAt: &closedAt,
+		}
+
+		mockRepo.EXPECT().GetReception(closed.Id).Return(closed, nil).Times(2)
+		mockRepo.EXPECT().GetOpenedReceptionId(closed.PvzId).Return(uuid.Nil, nil).Times(2)
+		gomock.InOrder(
+			mockRepo.EXPECT().HasNewerReception(closed.PvzId, closed.DateTime).Return(false, nil),
+			mockRepo.EXPECT().HasNewerReception(closed.PvzId, closed.DateTime).Return(true, nil),
+		)
+		mockRepo.EXPECT().ReopenReception(closed.Id, closed.Version, gomock.Any()).Return(nil, nil)
+
+		result, err := receptionSvc.ReopenReception(closed.Id, nil)
+
+		require.Equal(t, service.ReceptionNotLatest, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Reception not closed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		cancelled := &entity.Reception{Id: uuid.New(), Status: entity.ReceptionStatusCancelled}
+
+		mockRepo.EXPECT().GetReception(cancelled.Id).Return(cancelled, nil)
+
+		result, err := receptionSvc.ReopenReception(cancelled.Id, nil)
+
+		require.Equal(t, service.ReceptionNotClosed, err)
+		require.Nil(t, result)
+	})
+}
+
+func TestReceptionService_CancelReception(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		opened := &entity.Reception{Id: uuid.New(), Status: entity.ReceptionStatusInProgress}
+		reason := "opened by mistake"
+		cancelled := &entity.Reception{Id: opened.Id, Status: entity.ReceptionStatusCancelled, CancelReason: &reason}
+
+		mockRepo.EXPECT().GetReception(opened.Id).Return(opened, nil)
+		mockRepo.EXPECT().CancelReception(opened.Id, reason, opened.Version).Return(cancelled, nil)
+
+		result, err := receptionSvc.CancelReception(opened.Id, reason, nil)
+
+		require.NoError(t, err)
+		require.Equal(t, cancelled, result)
+	})
+
+	t.Run("Reception not opened", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		closed := &entity.Reception{Id: uuid.New(), Status: entity.ReceptionStatusClosed}
+
+		mockRepo.EXPECT().GetReception(closed.Id).Return(closed, nil)
+
+		result, err := receptionSvc.CancelReception(closed.Id, "reason", nil)
+
+		require.Equal(t, service.ReceptionNotOpened, err)
+		require.Nil(t, result)
+	})
+}
+
+func TestReceptionService_DeleteProduct(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		receptionID := uuid.New()
+		productID := uuid.New()
+
+		mockRepo.EXPECT().GetReception(receptionID).
+			Return(&entity.Reception{Id: receptionID, Status: entity.ReceptionStatusInProgress}, nil)
+		mockRepo.EXPECT().DeleteProduct(receptionID, productID).Return(true, nil)
+
+		err := receptionSvc.DeleteProduct(receptionID, productID)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Product not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		receptionID := uuid.New()
+		productID := uuid.New()
+
+		mockRepo.EXPECT().GetReception(receptionID).
+			Return(&entity.Reception{Id: receptionID, Status: entity.ReceptionStatusInProgress}, nil)
+		mockRepo.EXPECT().DeleteProduct(receptionID, productID).Return(false, nil)
+
+		err := receptionSvc.DeleteProduct(receptionID, productID)
+
+		require.Equal(t, service.ProductNotFound, err)
+	})
+
+	t.Run("Reception closed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		receptionID := uuid.New()
+
+		mockRepo.EXPECT().GetReception(receptionID).
+			Return(&entity.Reception{Id: receptionID, Status: entity.ReceptionStatusClosed}, nil)
+
+		err := receptionSvc.DeleteProduct(receptionID, uuid.New())
+
+		require.Equal(t, service.ReceptionNotOpened, err)
+	})
+}
+
+func TestReceptionService_CreateProducts(t *testing.T) {
+	t.Run("Atomic success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		pvzID := uuid.New()
+		receptionID := uuid.New()
+		products := []*entity.Product{{Type: entity.ProductTypeShoes}, {Type: entity.ProductTypeClothes}}
+
+		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(receptionID, nil)
+		mockRepo.EXPECT().GetActiveProductTypes().Return(activeProductTypes, nil)
+		mockRepo.EXPECT().GetRemainingProductCapacity(receptionID).Return(nil, nil)
+		mockRepo.EXPECT().CreateProducts(products, false).Return(make([]error, 2), nil)
+
+		results, err := receptionSvc.CreateProducts(products, pvzID, entity.ProductBatchModeAtomic)
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		require.Equal(t, receptionID, results[1].Product.ReceptionId)
+	})
+
+	t.Run("Atomic rejects whole batch", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		pvzID := uuid.New()
+		receptionID := uuid.New()
+		products := []*entity.Product{{Type: entity.ProductTypeShoes}, {Type: "мебель"}}
+
+		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(receptionID, nil)
+		mockRepo.EXPECT().GetActiveProductTypes().Return(activeProductTypes, nil)
+		mockRepo.EXPECT().GetRemainingProductCapacity(receptionID).Return(nil, nil)
+
+		results, err := receptionSvc.CreateProducts(products, pvzID, entity.ProductBatchModeAtomic)
+
+		require.Equal(t, service.ProductBatchRejected, err)
+		require.Nil(t, results[0].Product)
+		require.Equal(t, service.InvalidProductType, results[1].Err)
+	})
+
+	t.Run("Partial respects limit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		pvzID := uuid.New()
+		receptionID := uuid.New()
+		capacity := 1
+		products := []*entity.Product{{Type: entity.ProductTypeShoes}, {Type: entity.ProductTypeElectronics}}
+
+		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(receptionID, nil)
+		mockRepo.EXPECT().GetActiveProductTypes().Return(activeProductTypes, nil)
+		mockRepo.EXPECT().GetRemainingProductCapacity(receptionID).Return(&capacity, nil)
+		mockRepo.EXPECT().CreateProducts(products[:1], true).Return(make([]error, 1), nil)
+
+		results, err := receptionSvc.CreateProducts(products, pvzID, entity.ProductBatchModePartial)
+
+		require.NoError(t, err)
+		require.NotNil(t, results[0].Product)
+		require.Equal(t, service.ProductLimitReached, results[1].Err)
+	})
+
+	t.Run("Partial rejected under lock", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		pvzID := uuid.New()
+		receptionID := uuid.New()
+		products := []*entity.Product{{Type: entity.ProductTypeShoes}, {Type: entity.ProductTypeElectronics}}
+
+		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(receptionID, nil)
+		mockRepo.EXPECT().GetActiveProductTypes().Return(activeProductTypes, nil)
+		mockRepo.EXPECT().GetRemainingProductCapacity(receptionID).Return(nil, nil)
+		mockRepo.EXPECT().CreateProducts(products, true).Return([]error{nil, service.ProductLimitReached}, nil)
+
+		results, err := receptionSvc.CreateProducts(products, pvzID, entity.ProductBatchModePartial)
+
+		require.NoError(t, err)
+		require.NotNil(t, results[0].Product)
+		require.Nil(t, results[1].Product)
+		require.Equal(t, service.ProductLimitReached, results[1].Err)
+	})
+}
+
+func TestReceptionService_CreateProduct_Barcode(t *testing.T) {
+	t.Run("Duplicate scan", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		pvzID := uuid.New()
+		receptionID := uuid.New()
+		barcode := "PVZ0123456789"
+
+		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(receptionID, nil)
+		mockRepo.EXPECT().GetActiveProductTypes().Return(activeProductTypes, nil)
+		mockRepo.EXPECT().GetRemainingProductCapacity(receptionID).Return(nil, nil)
+		mockRepo.EXPECT().FindScannedBarcodes(receptionID, []string{barcode}).Return([]string{barcode}, nil)
+
+		result, err := receptionSvc.CreateProduct(&entity.Product{Type: entity.ProductTypeShoes, Barcode: &barcode}, pvzID)
+
+		require.Equal(t, service.DuplicateScan, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Invalid EAN-13 check digit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		receptionSvc := service.NewReceptionService(mocks.NewMockReceptionRepository(ctrl))
+
+		barcode := "4006381333932"
+
+		result, err := receptionSvc.CreateProduct(&entity.Product{Type: entity.ProductTypeShoes, Barcode: &barcode}, uuid.New())
+
+		require.Equal(t, service.InvalidBarcode, err)
+		require.Nil(t, result)
+	})
+}
+
+func TestReceptionService_CreateProducts_DuplicateInBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+	receptionSvc := service.NewReceptionService(mockRepo)
+
+	pvzID := uuid.New()
+	receptionID := uuid.New()
+	ean, parcel := "4006381333931", "PVZ0123456789"
+	products := []*entity.Product{
+		{Type: entity.ProductTypeShoes, Barcode: &ean},
+		{Type: entity.ProductTypeShoes, Barcode: &ean},
+		{Type: entity.ProductTypeClothes, Barcode: &parcel},
+	}
+
+	mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(receptionID, nil)
+	mockRepo.EXPECT().GetActiveProductTypes().Return(activeProductTypes, nil)
+	mockRepo.EXPECT().GetRemainingProductCapacity(receptionID).Return(nil, nil)
+	mockRepo.EXPECT().FindScannedBarcodes(receptionID, []string{ean, ean, parcel}).Return([]string{parcel}, nil)
+	mockRepo.EXPECT().CreateProducts(products[:1], true).Return(make([]error, 1), nil)
+
+	results, err := receptionSvc.CreateProducts(products, pvzID, entity.ProductBatchModePartial)
+
+	require.NoError(t, err)
+	require.NotNil(t, results[0].Product)
+	require.Equal(t, service.DuplicateScan, results[1].Err)
+	require.Equal(t, service.DuplicateScan, results[2].Err)
+}
+
+func TestReceptionService_StorageLimit(t *testing.T) {
+	maxVolume := int64(10000)
+	box := &entity.ProductDimensions{LengthCm: 20, WidthCm: 20, HeightCm: 10}
+
+	t.Run("Reject mode", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		pvzID := uuid.New()
+		receptionID := uuid.New()
+
+		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(receptionID, nil)
+		mockRepo.EXPECT().GetActiveProductTypes().Return(activeProductTypes, nil)
+		mockRepo.EXPECT().GetRemainingProductCapacity(receptionID).Return(nil, nil)
+		mockRepo.EXPECT().GetStorageUsage(receptionID).Return(&entity.StorageUsage{
+			UsedVolumeCm3: 8000, MaxVolumeCm3: &maxVolume, LimitMode: entity.StorageLimitModeReject,
+		}, nil)
+
+		result, err := receptionSvc.CreateProduct(&entity.Product{Type: entity.ProductTypeShoes, Dimensions: box}, pvzID)
+
+		require.Equal(t, service.StorageLimitReached, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Warn mode", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		pvzID := uuid.New()
+		receptionID := uuid.New()
+		product := &entity.Product{Type: entity.ProductTypeShoes, Dimensions: box}
+
+		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(receptionID, nil)
+		mockRepo.EXPECT().GetActiveProductTypes().Return(activeProductTypes, nil)
+		mockRepo.EXPECT().GetRemainingProductCapacity(receptionID).Return(nil, nil)
+		mockRepo.EXPECT().GetStorageUsage(receptionID).Return(&entity.StorageUsage{
+			UsedVolumeCm3: 8000, MaxVolumeCm3: &maxVolume, LimitMode: entity.StorageLimitModeWarn,
+		}, nil)
+		mockRepo.EXPECT().CreateProduct(product).Return(product, nil)
+
+		result, err := receptionSvc.CreateProduct(product, pvzID)
+
+		require.NoError(t, err)
+		require.True(t, result.StorageLimitExceeded)
+	})
+
+	t.Run("Invalid size", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		receptionSvc := service.NewReceptionService(mocks.NewMockReceptionRepository(ctrl))
+
+		result, err := receptionSvc.CreateProduct(&entity.Product{
+			Type:       entity.ProductTypeShoes,
+			Dimensions: &entity.ProductDimensions{LengthCm: 10, WidthCm: 0, HeightCm: 5},
+		}, uuid.New())
+
+		require.Equal(t, service.InvalidProductSize, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Partial batch counts accepted volume", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		pvzID := uuid.New()
+		receptionID := uuid.New()
+		products := []*entity.Product{
+			{Type: entity.ProductTypeShoes, Dimensions: box},
+			{Type: entity.ProductTypeShoes, Dimensions: box},
+			{Type: entity.ProductTypeClothes},
+		}
+
+		mockRepo.EXPECT().GetOpenedReceptionId(pvzID).Return(receptionID, nil)
+		mockRepo.EXPECT().GetActiveProductTypes().Return(activeProductTypes, nil)
+		mockRepo.EXPECT().GetRemainingProductCapacity(receptionID).Return(nil, nil)
+		mockRepo.EXPECT().GetStorageUsage(receptionID).Return(&entity.StorageUsage{
+			UsedVolumeCm3: 5000, MaxVolumeCm3: &maxVolume, LimitMode: entity.StorageLimitModeReject,
+		}, nil)
+		mockRepo.EXPECT().CreateProducts([]*entity.Product{products[0], products[2]}, true).Return(make([]error, 2), nil)
+
+		results, err := receptionSvc.CreateProducts(products, pvzID, entity.ProductBatchModePartial)
+
+		require.NoError(t, err)
+		require.NotNil(t, results[0].Product)
+		require.Equal(t, service.StorageLimitReached, results[1].Err)
+		require.NotNil(t, results[2].Product)
+	})
+}
+
+func TestReceptionService_ChangeProductStatus(t *testing.T) {
+	t.Run("Store product of closed reception", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		productID := uuid.New()
+		receptionID := uuid.New()
+		stored := &entity.Product{Id: productID, ReceptionId: receptionID, Status: entity.ProductStatusStored}
+
+		mockRepo.EXPECT().GetProduct(productID).
+			Return(&entity.Product{Id: productID, ReceptionId: receptionID, Status: entity.ProductStatusAccepted}, nil)
+		mockRepo.EXPECT().GetReception(receptionID).Return(&entity.Reception{Id: receptionID, Status: entity.ReceptionStatusClosed}, nil)
+		mockRepo.EXPECT().UpdateProductStatus(productID, entity.ProductStatusAccepted, entity.ProductStatusStored, gomock.Any()).
+			Return(stored, nil)
+
+		result, err := receptionSvc.ChangeProductStatus(productID, entity.ProductStatusStored)
+
+		require.NoError(t, err)
+		require.Equal(t, stored, result)
+	})
+
+	t.Run("Reception still open", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		productID := uuid.New()
+		receptionID := uuid.New()
+
+		mockRepo.EXPECT().GetProduct(productID).
+			Return(&entity.Product{Id: productID, ReceptionId: receptionID, Status: entity.ProductStatusAccepted}, nil)
+		mockRepo.EXPECT().GetReception(receptionID).Return(&entity.Reception{Id: receptionID, Status: entity.ReceptionStatusInProgress}, nil)
+
+		result, err := receptionSvc.ChangeProductStatus(productID, entity.ProductStatusStored)
+
+		require.Equal(t, service.ReceptionNotClosed, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Issue accepted product", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		productID := uuid.New()
+
+		mockRepo.EXPECT().GetProduct(productID).Return(&entity.Product{Id: productID, Status: entity.ProductStatusAccepted}, nil)
+
+		result, err := receptionSvc.ChangeProductStatus(productID, entity.ProductStatusIssued)
+
+		require.Equal(t, service.ProductStatusConflict, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Return issued product", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		productID := uuid.New()
+
+		mockRepo.EXPECT().GetProduct(productID).Return(&entity.Product{Id: productID, Status: entity.ProductStatusIssued}, nil)
+
+		result, err := receptionSvc.ChangeProductStatus(productID, entity.ProductStatusReturnedToSender)
+
+		require.Equal(t, service.ProductStatusConflict, err)
+		require.Nil(t, result)
+	})
+	t.Run("Status changed concurrently", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReceptionRepository(ctrl)
+
+		receptionSvc := service.NewReceptionService(mockRepo)
+
+		productID := uuid.New()
+
+		mockRepo.EXPECT().GetProduct(productID).Return(&entity.Product{Id: productID, Status: entity.ProductStatusStored}, nil)
+		mockRepo.EXPECT().UpdateProductStatus(productID, entity.ProductStatusStored, entity.ProductStatusIssued, gomock.Any()).
+			Return(nil, nil)
+
+		result, err := receptionSvc.ChangeProductStatus(productID, entity.ProductStatusIssued)
+
+		require.Equal(t, service.ProductStatusConflict, err)
+		require.Nil(t, result)
+	})
+}
+
+func TestReceptionService_GetProductsOnHand(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockReceptionRepository(ctrl)
+	receptionSvc := service.NewReceptionService(mockRepo)
+
+	pvzID := uuid.New()
+
+	mockRepo.EXPECT().GetProductsOnHand(pvzID, nil).Return([]*entity.Product{}, nil)
+	mockRepo.EXPECT().GetProductsOnHand(pvzID, []string{entity.ProductStatusStored}).Return([]*entity.Product{}, nil)
+
+	_, err := receptionSvc.GetProductsOnHand(pvzID, "")
+	require.NoError(t, err)
+
+	_, err = receptionSvc.GetProductsOnHand(pvzID, entity.ProductStatusStored)
+	require.NoError(t, err)
+
+	_, err = receptionSvc.GetProductsOnHand(pvzID, entity.ProductStatusIssued)
+	require.Equal(t, service.InvalidProductStatus, err)
+}
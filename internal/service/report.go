@@ -0,0 +1,74 @@
+package service
+
+import (
+	"log"
+	"time"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
+)
+
+var InvalidDateRange = apperror.Validation("invalid_date_range", "start date is after end date")
+
+type ReportRepository interface {
+	GetStats(req *request.Stats) (*response.Stats, error)
+	ExportReceptions(req *request.Export, fn func(row *response.ExportRow) error) error
+	BuildDailyReport(date time.Time) error
+	GetMissingDailyReportDates(from, to time.Time) ([]time.Time, error)
+	GetDailyReport(date time.Time) ([]response.DailyReport, error)
+}
+
+type ReportService struct {
+	reportRepo ReportRepository
+}
+
+func NewReportService(reportRepo ReportRepository) *ReportService {
+	return &ReportService{
+		reportRepo: reportRepo,
+	}
+}
+
+func (s *ReportService) GetStats(req *request.Stats) (*response.Stats, error) {
+	if req.StartDate != nil && req.EndDate != nil && req.StartDate.After(*req.EndDate) {
+		return nil, InvalidDateRange
+	}
+
+	return s.reportRepo.GetStats(req)
+}
+
+func (s *ReportService) ExportReceptions(req *request.Export, fn func(row *response.ExportRow) error) error {
+	if req.StartDate != nil && req.EndDate != nil && req.StartDate.After(*req.EndDate) {
+		return InvalidDateRange
+	}
+
+	return s.reportRepo.ExportReceptions(req, fn)
+}
+
+func (s *ReportService) BuildDailyReport(date time.Time) error {
+	return s.reportRepo.BuildDailyReport(date)
+}
+
+// BackfillDailyReports строит сводки за дни периода, по которым они еще не построены.
+func (s *ReportService) BackfillDailyReports(from, to time.Time) error {
+	log.SetPrefix("ReportService.BackfillDailyReports")
+
+	dates, err := s.reportRepo.GetMissingDailyReportDates(from, to)
+	if err != nil {
+		return err
+	}
+
+	for _, date := range dates {
+		if err = s.reportRepo.BuildDailyReport(date); err != nil {
+			return err
+		}
+
+		log.Printf("daily report for %s built", date.Format(time.DateOnly))
+	}
+
+	return nil
+}
+
+func (s *ReportService) GetDailyReport(date time.Time) ([]response.DailyReport, error) {
+	return s.reportRepo.GetDailyReport(date)
+}
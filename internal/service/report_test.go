@@ -0,0 +1,83 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
+	"github.com/alexey-shedrin/avito-test-task/internal/repository/mocks"
+	"github.com/alexey-shedrin/avito-test-task/internal/service"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReportService_GetStats(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReportRepository(ctrl)
+		reportSvc := service.NewReportService(mockRepo)
+
+		req := &request.Stats{}
+		expected := &response.Stats{ReceptionCount: 1}
+		mockRepo.EXPECT().GetStats(req).Return(expected, nil)
+
+		stats, err := reportSvc.GetStats(req)
+
+		require.NoError(t, err)
+		require.Equal(t, expected, stats)
+	})
+
+	t.Run("Invalid date range", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		reportSvc := service.NewReportService(mocks.NewMockReportRepository(ctrl))
+
+		start := time.Now()
+		end := start.Add(-time.Hour)
+
+		stats, err := reportSvc.GetStats(&request.Stats{StartDate: &start, EndDate: &end})
+
+		require.Equal(t, service.InvalidDateRange, err)
+		require.Nil(t, stats)
+	})
+}
+
+func TestReportService_BackfillDailyReports(t *testing.T) {
+	t.Run("Builds only missing days", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReportRepository(ctrl)
+		reportSvc := service.NewReportService(mockRepo)
+
+		from := time.Date(2025, 4, 18, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2025, 4, 20, 0, 0, 0, 0, time.UTC)
+		missing := from.AddDate(0, 0, 1)
+
+		mockRepo.EXPECT().GetMissingDailyReportDates(from, to).Return([]time.Time{missing}, nil)
+		mockRepo.EXPECT().BuildDailyReport(missing).Return(nil)
+
+		require.NoError(t, reportSvc.BackfillDailyReports(from, to))
+	})
+
+	t.Run("Stops on build error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockReportRepository(ctrl)
+		reportSvc := service.NewReportService(mockRepo)
+
+		buildErr := errors.New("database error")
+		day := time.Date(2025, 4, 18, 0, 0, 0, 0, time.UTC)
+
+		mockRepo.EXPECT().GetMissingDailyReportDates(day, day).Return([]time.Time{day, day}, nil)
+		mockRepo.EXPECT().BuildDailyReport(day).Return(buildErr)
+
+		require.Equal(t, buildErr, reportSvc.BackfillDailyReports(day, day))
+	})
+}
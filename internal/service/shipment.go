@@ -0,0 +1,156 @@
+package service
+
+import (
+	"log"
+	"time"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/alexey-shedrin/avito-test-task/internal/repository"
+	"github.com/google/uuid"
+)
+
+var (
+	ShipmentAlreadyOpened = repository.ErrShipmentAlreadyOpened
+	ShipmentNotOpened     = repository.ErrShipmentNotOpened
+	ProductNotStored      = apperror.Conflict("product_not_stored", "only stored products can be shipped")
+	ProductInShipment     = apperror.Conflict("product_in_shipment", "product is attached to a shipment")
+	ProductFromOtherPvz   = apperror.Validation("product_from_other_pvz", "product belongs to another pvz")
+)
+
+type ShipmentRepository interface {
+	GetOpenedShipmentId(pvzID uuid.UUID) (uuid.UUID, error)
+	CreateShipment(shipment *entity.Shipment) (*entity.Shipment, error)
+	GetShipment(shipmentID uuid.UUID) (*entity.Shipment, error)
+	GetProduct(productID uuid.UUID) (*entity.Product, uuid.UUID, error)
+	GetShipmentProducts(shipmentID uuid.UUID) ([]*entity.Product, error)
+	AttachProduct(shipmentID, productID uuid.UUID) (*entity.Product, error)
+	DetachProduct(shipmentID, productID uuid.UUID) (bool, error)
+	CloseShipment(shipmentID uuid.UUID, at time.Time) (*entity.Shipment, error)
+}
+
+// ShipmentService ведет отгрузки невостребованных товаров по тем же правилам, что и приемки:
+// в ПВЗ одновременно открыта не более чем одна отгрузка.
+type ShipmentService struct {
+	shipmentRepo ShipmentRepository
+}
+
+func NewShipmentService(shipmentRepo ShipmentRepository) *ShipmentService {
+	return &ShipmentService{
+		shipmentRepo: shipmentRepo,
+	}
+}
+
+func (s *ShipmentService) CreateShipment(shipment *entity.Shipment) (*entity.Shipment, error) {
+	log.SetPrefix("ShipmentService.CreateShipment")
+
+	id, err := s.shipmentRepo.GetOpenedShipmentId(shipment.PvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	if id != uuid.Nil {
+		return nil, ShipmentAlreadyOpened
+	}
+
+	shipment, err = s.shipmentRepo.CreateShipment(shipment)
+	if err != nil {
+		return nil, err
+	}
+
+	return shipment, nil
+}
+
+func (s *ShipmentService) GetShipment(shipmentID uuid.UUID) (*entity.Shipment, []*entity.Product, error) {
+	shipment, err := s.shipmentRepo.GetShipment(shipmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	products, err := s.shipmentRepo.GetShipmentProducts(shipmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return shipment, products, nil
+}
+
+// AddProduct прикрепляет хранящийся товар ПВЗ к открытой отгрузке этого ПВЗ.
+func (s *ShipmentService) AddProduct(shipmentID, productID uuid.UUID) (*entity.Product, error) {
+	log.SetPrefix("ShipmentService.AddProduct")
+
+	shipment, err := s.shipmentRepo.GetShipment(shipmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if shipment.Status != entity.ShipmentStatusInProgress {
+		return nil, ShipmentNotOpened
+	}
+
+	product, pvzID, err := s.shipmentRepo.GetProduct(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case pvzID != shipment.PvzId:
+		return nil, ProductFromOtherPvz
+	case product.ShipmentId != nil:
+		return nil, ProductInShipment
+	case product.Status != entity.ProductStatusStored:
+		return nil, ProductNotStored
+	}
+
+	product, err = s.shipmentRepo.AttachProduct(shipmentID, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+func (s *ShipmentService) DeleteProduct(shipmentID, productID uuid.UUID) error {
+	log.SetPrefix("ShipmentService.DeleteProduct")
+
+	shipment, err := s.shipmentRepo.GetShipment(shipmentID)
+	if err != nil {
+		return err
+	}
+
+	if shipment.Status != entity.ShipmentStatusInProgress {
+		return ShipmentNotOpened
+	}
+
+	detached, err := s.shipmentRepo.DetachProduct(shipmentID, productID)
+	if err != nil {
+		return err
+	}
+
+	if !detached {
+		return ProductNotFound
+	}
+
+	return nil
+}
+
+// CloseLastShipment закрывает открытую отгрузку ПВЗ, ее товары переходят в статус returned_to_sender.
+func (s *ShipmentService) CloseLastShipment(pvzID uuid.UUID) (*entity.Shipment, error) {
+	log.SetPrefix("ShipmentService.CloseLastShipment")
+
+	id, err := s.shipmentRepo.GetOpenedShipmentId(pvzID)
+	if err != nil {
+		return nil, err
+	}
+
+	if id == uuid.Nil {
+		return nil, ShipmentNotOpened
+	}
+
+	shipment, err := s.shipmentRepo.CloseShipment(id, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	return shipment, nil
+}
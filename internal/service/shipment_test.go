@@ -0,0 +1,170 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
+	"github.com/alexey-shedrin/avito-test-task/internal/repository/mocks"
+	"github.com/alexey-shedrin/avito-test-task/internal/service"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestShipmentService_CreateShipment(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockShipmentRepository(ctrl)
+
+		shipmentSvc := service.NewShipmentService(mockRepo)
+
+		shipment := &entity.Shipment{PvzId: uuid.New()}
+
+		mockRepo.EXPECT().GetOpenedShipmentId(shipment.PvzId).Return(uuid.Nil, nil)
+		mockRepo.EXPECT().CreateShipment(shipment).Return(shipment, nil)
+
+		result, err := shipmentSvc.CreateShipment(shipment)
+
+		require.NoError(t, err)
+		require.Equal(t, shipment, result)
+	})
+
+	t.Run("Shipment already opened", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockShipmentRepository(ctrl)
+
+		shipmentSvc := service.NewShipmentService(mockRepo)
+
+		shipment := &entity.Shipment{PvzId: uuid.New()}
+
+		mockRepo.EXPECT().GetOpenedShipmentId(shipment.PvzId).Return(uuid.New(), nil)
+
+		result, err := shipmentSvc.CreateShipment(shipment)
+
+		require.Equal(t, service.ShipmentAlreadyOpened, err)
+		require.Nil(t, result)
+	})
+}
+
+func TestShipmentService_AddProduct(t *testing.T) {
+	pvzID := uuid.New()
+	shipmentID := uuid.New()
+	productID := uuid.New()
+	openShipment := &entity.Shipment{Id: shipmentID, PvzId: pvzID, Status: entity.ShipmentStatusInProgress}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockShipmentRepository(ctrl)
+
+		shipmentSvc := service.NewShipmentService(mockRepo)
+
+		attached := &entity.Product{Id: productID, Status: entity.ProductStatusStored, ShipmentId: &shipmentID}
+
+		mockRepo.EXPECT().GetShipment(shipmentID).Return(openShipment, nil)
+		mockRepo.EXPECT().GetProduct(productID).Return(&entity.Product{Id: productID, Status: entity.ProductStatusStored}, pvzID, nil)
+		mockRepo.EXPECT().AttachProduct(shipmentID, productID).Return(attached, nil)
+
+		result, err := shipmentSvc.AddProduct(shipmentID, productID)
+
+		require.NoError(t, err)
+		require.Equal(t, attached, result)
+	})
+
+	t.Run("Product is not stored", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockShipmentRepository(ctrl)
+
+		shipmentSvc := service.NewShipmentService(mockRepo)
+
+		mockRepo.EXPECT().GetShipment(shipmentID).Return(openShipment, nil)
+		mockRepo.EXPECT().GetProduct(productID).Return(&entity.Product{Id: productID, Status: entity.ProductStatusIssued}, pvzID, nil)
+
+		result, err := shipmentSvc.AddProduct(shipmentID, productID)
+
+		require.Equal(t, service.ProductNotStored, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Product from other pvz", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockShipmentRepository(ctrl)
+
+		shipmentSvc := service.NewShipmentService(mockRepo)
+
+		mockRepo.EXPECT().GetShipment(shipmentID).Return(openShipment, nil)
+		mockRepo.EXPECT().GetProduct(productID).Return(&entity.Product{Id: productID, Status: entity.ProductStatusStored}, uuid.New(), nil)
+
+		result, err := shipmentSvc.AddProduct(shipmentID, productID)
+
+		require.Equal(t, service.ProductFromOtherPvz, err)
+		require.Nil(t, result)
+	})
+
+	t.Run("Shipment closed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockShipmentRepository(ctrl)
+
+		shipmentSvc := service.NewShipmentService(mockRepo)
+
+		mockRepo.EXPECT().GetShipment(shipmentID).
+			Return(&entity.Shipment{Id: shipmentID, PvzId: pvzID, Status: entity.ShipmentStatusClosed}, nil)
+
+		result, err := shipmentSvc.AddProduct(shipmentID, productID)
+
+		require.Equal(t, service.ShipmentNotOpened, err)
+		require.Nil(t, result)
+	})
+}
+
+func TestShipmentService_CloseLastShipment(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockShipmentRepository(ctrl)
+
+		shipmentSvc := service.NewShipmentService(mockRepo)
+
+		pvzID := uuid.New()
+		shipmentID := uuid.New()
+		closed := &entity.Shipment{Id: shipmentID, PvzId: pvzID, Status: entity.ShipmentStatusClosed}
+
+		mockRepo.EXPECT().GetOpenedShipmentId(pvzID).Return(shipmentID, nil)
+		mockRepo.EXPECT().CloseShipment(shipmentID, gomock.Any()).Return(closed, nil)
+
+		result, err := shipmentSvc.CloseLastShipment(pvzID)
+
+		require.NoError(t, err)
+		require.Equal(t, closed, result)
+	})
+
+	t.Run("No opened shipment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockShipmentRepository(ctrl)
+
+		shipmentSvc := service.NewShipmentService(mockRepo)
+
+		pvzID := uuid.New()
+
+		mockRepo.EXPECT().GetOpenedShipmentId(pvzID).Return(uuid.Nil, nil)
+
+		result, err := shipmentSvc.CloseLastShipment(pvzID)
+
+		require.Equal(t, service.ShipmentNotOpened, err)
+		require.Nil(t, result)
+	})
+}
@@ -1,9 +1,9 @@
 package service
 
 import (
-	"errors"
 	"log"
 
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/request"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/dto/response"
 	"github.com/alexey-shedrin/avito-test-task/internal/model/entity"
@@ -11,7 +11,7 @@ import (
 	"github.com/google/uuid"
 )
 
-var InvalidCredentials = errors.New("invalid credentials")
+var InvalidCredentials = apperror.Unauthorized("invalid_credentials", "invalid credentials")
 
 type UserRepository interface {
 	Create(user *entity.User) error
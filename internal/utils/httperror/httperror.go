@@ -1,24 +1,99 @@
 package httperror
 
-import "net/http"
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
 
-type HttpError struct {
-	Code     int    `json:"code"`
-	Message  string `json:"message"`
-	AppError error  `json:"error"`
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	ContentType = "application/problem+json"
+	problemType = "about:blank"
+)
+
+var ValidationFailed = apperror.Validation(apperror.CodeValidationFailed, "request validation failed")
+
+// Problem тело ответа с ошибкой по RFC 7807, схема Error в OpenAPI. Code, Details и
+// Errors - расширения: машинный код ошибки, ее данные и нарушения валидации по полям.
+type Problem struct {
+	Type     string                `json:"type"`
+	Title    string                `json:"title"`
+	Status   int                   `json:"status"`
+	Detail   string                `json:"detail"`
+	Instance string                `json:"instance,omitempty"`
+	Code     string                `json:"code"`
+	Details  map[string]any        `json:"details,omitempty"`
+	Errors   []apperror.FieldError `json:"errors,omitempty"`
 }
 
-func (e HttpError) Error() string {
-	return e.Message
+var statuses = map[apperror.Kind]int{
+	apperror.KindValidation:         http.StatusBadRequest,
+	apperror.KindUnauthorized:       http.StatusUnauthorized,
+	apperror.KindForbidden:          http.StatusForbidden,
+	apperror.KindNotFound:           http.StatusNotFound,
+	apperror.KindConflict:           http.StatusConflict,
+	apperror.KindPreconditionFailed: http.StatusPreconditionFailed,
+	apperror.KindUnprocessable:      http.StatusUnprocessableEntity,
+	apperror.KindInternal:           http.StatusInternalServerError,
 }
-func NewInternal(msg string, err error) error {
-	return HttpError{Code: http.StatusInternalServerError, Message: msg, AppError: err}
+
+// Status возвращает HTTP-статус для ошибки err.
+func Status(err error) int {
+	if status, ok := statuses[apperror.From(err).Kind]; ok {
+		return status
+	}
+
+	return http.StatusInternalServerError
 }
 
-func NewBadReq(msg string, err error) error {
-	return HttpError{Code: http.StatusBadRequest, Message: msg, AppError: err}
+// Respond прерывает обработку запроса и отвечает ошибкой err в формате problem+json.
+// Внутренние ошибки логируются, а клиент получает только общий текст без деталей.
+func Respond(c *gin.Context, err error) {
+	appErr := apperror.From(err)
+	if appErr.Kind == apperror.KindInternal {
+		log.Printf("error: %v", err)
+	}
+
+	status := Status(appErr)
+	c.Header("Content-Type", ContentType)
+	c.AbortWithStatusJSON(status, Problem{
+		Type:     problemType,
+		Title:    http.StatusText(status),
+		Status:   status,
+		Detail:   appErr.Message,
+		Instance: c.Request.URL.Path,
+		Code:     appErr.Code,
+		Details:  appErr.Details,
+		Errors:   appErr.Fields,
+	})
+}
+
+// BadRequest отвечает ошибкой валидации запроса с текстом message.
+func BadRequest(c *gin.Context, message string) {
+	Respond(c, apperror.Validation(apperror.CodeInvalidRequest, message))
+}
+
+// BindError отвечает ошибкой разбора тела запроса. Значение неверного типа
+// указывается по полю.
+func BindError(c *gin.Context, err error) {
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) && typeErr.Field != "" {
+		Respond(c, ValidationFailed.WithFields([]apperror.FieldError{{
+			Field: typeErr.Field,
+			Rule:  "type",
+			Param: typeErr.Type.String(),
+		}}))
+		return
+	}
+
+	BadRequest(c, err.Error())
 }
 
-func NewUnauthorized(msg string, err error) error {
-	return HttpError{Code: http.StatusUnauthorized, Message: msg, AppError: err}
+// ErrorHandler обработчик ошибок разбора параметров для сгенерированного сервера.
+func ErrorHandler(c *gin.Context, err error, _ int) {
+	BadRequest(c, err.Error())
 }
@@ -0,0 +1,103 @@
+package httperror_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	openapi "github.com/alexey-shedrin/avito-test-task/internal/gen"
+	"github.com/alexey-shedrin/avito-test-task/internal/model/apperror"
+	"github.com/alexey-shedrin/avito-test-task/internal/utils/httperror"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func respond(t *testing.T, write func(c *gin.Context)) (int, httperror.Problem) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/pvz", nil)
+
+	write(c)
+	require.True(t, c.IsAborted())
+	require.Equal(t, httperror.ContentType, w.Header().Get("Content-Type"))
+
+	var resp httperror.Problem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, w.Code, resp.Status)
+	require.Equal(t, http.StatusText(w.Code), resp.Title)
+
+	return w.Code, resp
+}
+
+func bind(t *testing.T, body string) (int, httperror.Problem) {
+	return respond(t, func(c *gin.Context) {
+		var req openapi.PostRegisterJSONRequestBody
+		err := json.Unmarshal([]byte(body), &req)
+		require.Error(t, err)
+
+		httperror.BindError(c, err)
+	})
+}
+
+func TestStatus(t *testing.T) {
+	tests := []struct {
+		err    error
+		status int
+	}{
+		{apperror.Validation("invalid_sort", "invalid sort"), http.StatusBadRequest},
+		{apperror.Unauthorized("invalid_token", "invalid token"), http.StatusUnauthorized},
+		{apperror.Forbidden("forbidden", "access denied"), http.StatusForbidden},
+		{apperror.NotFound("pvz_not_found", "pvz not found"), http.StatusNotFound},
+		{apperror.Conflict("reception_not_opened", "reception is not opened"), http.StatusConflict},
+		{apperror.PreconditionFailed("version_mismatch", "version mismatch"), http.StatusPreconditionFailed},
+		{apperror.Unprocessable("product_batch_rejected", "rejected"), http.StatusUnprocessableEntity},
+		{errors.New("connection refused"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.status, httperror.Status(tt.err), tt.err.Error())
+	}
+}
+
+func TestRespond_WrappedError(t *testing.T) {
+	notFound := apperror.NotFound("pvz_not_found", "pvz not found")
+
+	status, resp := respond(t, func(c *gin.Context) {
+		httperror.Respond(c, fmt.Errorf("get pvz: %w", notFound.WithDetails(map[string]any{"id": "1"})))
+	})
+
+	require.Equal(t, http.StatusNotFound, status)
+	require.Equal(t, "pvz_not_found", resp.Code)
+	require.Equal(t, "pvz not found", resp.Detail)
+	require.Equal(t, map[string]any{"id": "1"}, resp.Details)
+	require.Equal(t, "/pvz", resp.Instance)
+}
+
+func TestRespond_HidesInternalError(t *testing.T) {
+	status, resp := respond(t, func(c *gin.Context) {
+		httperror.Respond(c, errors.New("pq: password authentication failed"))
+	})
+
+	require.Equal(t, http.StatusInternalServerError, status)
+	require.Equal(t, apperror.CodeInternal, resp.Code)
+	require.NotContains(t, resp.Detail, "pq:")
+}
+
+func TestBindError_WrongType(t *testing.T) {
+	status, resp := bind(t, `{"email":"user@mail.com","password":1,"role":"client"}`)
+
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Equal(t, []apperror.FieldError{{Field: "password", Rule: "type", Param: "string"}}, resp.Errors)
+}
+
+func TestBindError_MalformedBody(t *testing.T) {
+	status, resp := bind(t, `{"email":`)
+
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Equal(t, apperror.CodeInvalidRequest, resp.Code)
+	require.Empty(t, resp.Errors)
+}
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_reception_pvz_id_datetime ON reception (pvz_id, reception_datetime);
+CREATE INDEX IF NOT EXISTS idx_reception_datetime ON reception (reception_datetime);
+CREATE INDEX IF NOT EXISTS idx_product_reception_id ON product (reception_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_product_reception_id;
+DROP INDEX IF EXISTS idx_reception_datetime;
+DROP INDEX IF EXISTS idx_reception_pvz_id_datetime;
+-- +goose StatementEnd
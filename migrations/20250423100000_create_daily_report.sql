@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS daily_report (
+    report_date DATE NOT NULL,
+    pvz_id UUID NOT NULL,
+    reception_count INTEGER NOT NULL,
+    product_count INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (report_date, pvz_id),
+    FOREIGN KEY (pvz_id) REFERENCES pvz(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS daily_report;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE reception ADD COLUMN IF NOT EXISTS closed_at TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reception DROP COLUMN IF EXISTS closed_at;
+-- +goose StatementEnd
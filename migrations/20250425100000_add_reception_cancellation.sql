@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE reception ADD COLUMN IF NOT EXISTS cancel_reason varchar;
+ALTER TABLE reception ADD COLUMN IF NOT EXISTS cancelled_at TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reception DROP COLUMN IF EXISTS cancelled_at;
+ALTER TABLE reception DROP COLUMN IF EXISTS cancel_reason;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS max_products_per_reception INTEGER CHECK (max_products_per_reception > 0);
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS max_open_minutes INTEGER CHECK (max_open_minutes > 0);
+ALTER TABLE reception ADD COLUMN IF NOT EXISTS auto_closed BOOLEAN NOT NULL DEFAULT false;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reception DROP COLUMN IF EXISTS auto_closed;
+ALTER TABLE pvz DROP COLUMN IF EXISTS max_open_minutes;
+ALTER TABLE pvz DROP COLUMN IF EXISTS max_products_per_reception;
+-- +goose StatementEnd
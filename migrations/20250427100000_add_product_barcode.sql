@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE product ADD COLUMN IF NOT EXISTS barcode varchar;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_product_reception_id_barcode ON product (reception_id, barcode) WHERE barcode IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_product_barcode ON product (barcode) WHERE barcode IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_product_barcode;
+DROP INDEX IF EXISTS idx_product_reception_id_barcode;
+ALTER TABLE product DROP COLUMN IF EXISTS barcode;
+-- +goose StatementEnd
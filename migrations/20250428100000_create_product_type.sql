@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS product_type (
+    name varchar PRIMARY KEY,
+    deprecated BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+INSERT INTO product_type (name) VALUES ('электроника'), ('одежда'), ('обувь')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO product_type (name) SELECT DISTINCT product_type FROM product
+ON CONFLICT (name) DO NOTHING;
+
+ALTER TABLE product
+    ADD CONSTRAINT product_product_type_fkey FOREIGN KEY (product_type) REFERENCES product_type(name);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE product DROP CONSTRAINT IF EXISTS product_product_type_fkey;
+DROP TABLE IF EXISTS product_type;
+-- +goose StatementEnd
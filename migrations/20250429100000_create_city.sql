@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS city (
+    name varchar PRIMARY KEY,
+    region varchar NOT NULL,
+    timezone varchar NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+INSERT INTO city (name, region, timezone) VALUES
+    ('Москва', 'Москва', 'Europe/Moscow'),
+    ('Санкт-Петербург', 'Санкт-Петербург', 'Europe/Moscow'),
+    ('Казань', 'Республика Татарстан', 'Europe/Moscow')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO city (name, region, timezone) SELECT DISTINCT city, city, 'Europe/Moscow' FROM pvz
+ON CONFLICT (name) DO NOTHING;
+
+ALTER TABLE pvz
+    ADD CONSTRAINT pvz_city_fkey FOREIGN KEY (city) REFERENCES city(name) ON UPDATE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pvz DROP CONSTRAINT IF EXISTS pvz_city_fkey;
+DROP TABLE IF EXISTS city;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS address varchar;
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90);
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180);
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS opening_hours varchar;
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS phone varchar;
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS status varchar NOT NULL DEFAULT 'active'
+    CHECK (status IN ('active', 'suspended', 'closed'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pvz DROP COLUMN IF EXISTS status;
+ALTER TABLE pvz DROP COLUMN IF EXISTS phone;
+ALTER TABLE pvz DROP COLUMN IF EXISTS opening_hours;
+ALTER TABLE pvz DROP COLUMN IF EXISTS longitude;
+ALTER TABLE pvz DROP COLUMN IF EXISTS latitude;
+ALTER TABLE pvz DROP COLUMN IF EXISTS address;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS archived BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS archived_at TIMESTAMP;
+
+-- История приемок и товаров не должна пропадать вместе с ПВЗ, ПВЗ только архивируются.
+ALTER TABLE reception DROP CONSTRAINT IF EXISTS reception_pvz_id_fkey;
+ALTER TABLE reception ADD CONSTRAINT reception_pvz_id_fkey
+    FOREIGN KEY (pvz_id) REFERENCES pvz(id) ON DELETE RESTRICT;
+
+ALTER TABLE product DROP CONSTRAINT IF EXISTS product_reception_id_fkey;
+ALTER TABLE product ADD CONSTRAINT product_reception_id_fkey
+    FOREIGN KEY (reception_id) REFERENCES reception(id) ON DELETE RESTRICT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE product DROP CONSTRAINT IF EXISTS product_reception_id_fkey;
+ALTER TABLE product ADD CONSTRAINT product_reception_id_fkey
+    FOREIGN KEY (reception_id) REFERENCES reception(id) ON DELETE CASCADE;
+
+ALTER TABLE reception DROP CONSTRAINT IF EXISTS reception_pvz_id_fkey;
+ALTER TABLE reception ADD CONSTRAINT reception_pvz_id_fkey
+    FOREIGN KEY (pvz_id) REFERENCES pvz(id) ON DELETE CASCADE;
+
+ALTER TABLE pvz DROP COLUMN IF EXISTS archived_at;
+ALTER TABLE pvz DROP COLUMN IF EXISTS archived;
+-- +goose StatementEnd
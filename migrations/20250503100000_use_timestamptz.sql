@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Время до миграции записывалось в часовом поясе сервера, который в окружении приложения равен UTC.
+ALTER TABLE pvz ALTER COLUMN registration_date TYPE TIMESTAMPTZ USING registration_date::timestamp AT TIME ZONE 'UTC';
+ALTER TABLE pvz ALTER COLUMN archived_at TYPE TIMESTAMPTZ USING archived_at AT TIME ZONE 'UTC';
+
+ALTER TABLE reception ALTER COLUMN reception_datetime TYPE TIMESTAMPTZ USING reception_datetime AT TIME ZONE 'UTC';
+ALTER TABLE reception ALTER COLUMN closed_at TYPE TIMESTAMPTZ USING closed_at AT TIME ZONE 'UTC';
+ALTER TABLE reception ALTER COLUMN cancelled_at TYPE TIMESTAMPTZ USING cancelled_at AT TIME ZONE 'UTC';
+
+ALTER TABLE product ALTER COLUMN acceptance_datetime TYPE TIMESTAMPTZ USING acceptance_datetime AT TIME ZONE 'UTC';
+
+ALTER TABLE daily_report ALTER COLUMN created_at TYPE TIMESTAMPTZ USING created_at AT TIME ZONE 'UTC';
+ALTER TABLE product_type ALTER COLUMN created_at TYPE TIMESTAMPTZ USING created_at AT TIME ZONE 'UTC';
+ALTER TABLE city ALTER COLUMN created_at TYPE TIMESTAMPTZ USING created_at AT TIME ZONE 'UTC';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE city ALTER COLUMN created_at TYPE TIMESTAMP USING created_at AT TIME ZONE 'UTC';
+ALTER TABLE product_type ALTER COLUMN created_at TYPE TIMESTAMP USING created_at AT TIME ZONE 'UTC';
+ALTER TABLE daily_report ALTER COLUMN created_at TYPE TIMESTAMP USING created_at AT TIME ZONE 'UTC';
+
+ALTER TABLE product ALTER COLUMN acceptance_datetime TYPE TIMESTAMP USING acceptance_datetime AT TIME ZONE 'UTC';
+
+ALTER TABLE reception ALTER COLUMN cancelled_at TYPE TIMESTAMP USING cancelled_at AT TIME ZONE 'UTC';
+ALTER TABLE reception ALTER COLUMN closed_at TYPE TIMESTAMP USING closed_at AT TIME ZONE 'UTC';
+ALTER TABLE reception ALTER COLUMN reception_datetime TYPE TIMESTAMP USING reception_datetime AT TIME ZONE 'UTC';
+
+ALTER TABLE pvz ALTER COLUMN archived_at TYPE TIMESTAMP USING archived_at AT TIME ZONE 'UTC';
+ALTER TABLE pvz ALTER COLUMN registration_date TYPE DATE USING (registration_date AT TIME ZONE 'UTC')::date;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE product ADD COLUMN IF NOT EXISTS weight_grams INTEGER CHECK (weight_grams > 0);
+ALTER TABLE product ADD COLUMN IF NOT EXISTS length_cm INTEGER CHECK (length_cm > 0);
+ALTER TABLE product ADD COLUMN IF NOT EXISTS width_cm INTEGER CHECK (width_cm > 0);
+ALTER TABLE product ADD COLUMN IF NOT EXISTS height_cm INTEGER CHECK (height_cm > 0);
+ALTER TABLE product ADD CONSTRAINT product_dimensions_check
+    CHECK ((length_cm IS NULL) = (width_cm IS NULL) AND (width_cm IS NULL) = (height_cm IS NULL));
+
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS max_storage_volume_cm3 BIGINT CHECK (max_storage_volume_cm3 > 0);
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS storage_limit_mode varchar NOT NULL DEFAULT 'reject'
+    CHECK (storage_limit_mode IN ('warn', 'reject'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pvz DROP COLUMN IF EXISTS storage_limit_mode;
+ALTER TABLE pvz DROP COLUMN IF EXISTS max_storage_volume_cm3;
+
+ALTER TABLE product DROP CONSTRAINT IF EXISTS product_dimensions_check;
+ALTER TABLE product DROP COLUMN IF EXISTS height_cm;
+ALTER TABLE product DROP COLUMN IF EXISTS width_cm;
+ALTER TABLE product DROP COLUMN IF EXISTS length_cm;
+ALTER TABLE product DROP COLUMN IF EXISTS weight_grams;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE product ADD COLUMN IF NOT EXISTS status varchar NOT NULL DEFAULT 'accepted'
+    CHECK (status IN ('accepted', 'stored', 'issued', 'returned_to_sender'));
+ALTER TABLE product ADD COLUMN IF NOT EXISTS stored_at TIMESTAMPTZ;
+ALTER TABLE product ADD COLUMN IF NOT EXISTS issued_at TIMESTAMPTZ;
+ALTER TABLE product ADD COLUMN IF NOT EXISTS returned_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_product_on_hand ON product (reception_id) WHERE status IN ('accepted', 'stored');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_product_on_hand;
+
+ALTER TABLE product DROP COLUMN IF EXISTS returned_at;
+ALTER TABLE product DROP COLUMN IF EXISTS issued_at;
+ALTER TABLE product DROP COLUMN IF EXISTS stored_at;
+ALTER TABLE product DROP COLUMN IF EXISTS status;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS shipment (
+    id UUID PRIMARY KEY,
+    pvz_id UUID NOT NULL,
+    status varchar NOT NULL CHECK (status IN ('in_progress', 'closed')),
+    shipment_datetime TIMESTAMPTZ NOT NULL,
+    closed_at TIMESTAMPTZ,
+    FOREIGN KEY (pvz_id) REFERENCES pvz(id) ON DELETE RESTRICT
+);
+
+-- В ПВЗ может быть открыта только одна отгрузка.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_shipment_pvz_id_in_progress ON shipment (pvz_id) WHERE status = 'in_progress';
+
+ALTER TABLE product ADD COLUMN IF NOT EXISTS shipment_id UUID REFERENCES shipment(id) ON DELETE RESTRICT;
+CREATE INDEX IF NOT EXISTS idx_product_shipment_id ON product (shipment_id) WHERE shipment_id IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_product_shipment_id;
+ALTER TABLE product DROP COLUMN IF EXISTS shipment_id;
+
+DROP TABLE IF EXISTS shipment;
+-- +goose StatementEnd
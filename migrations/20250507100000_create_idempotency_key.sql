@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS idempotency_key (
+    key varchar NOT NULL,
+    method varchar NOT NULL,
+    path varchar NOT NULL,
+    request_hash varchar NOT NULL,
+    status_code INT,
+    content_type varchar,
+    response_body BYTEA,
+    created_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (key, method, path)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_key_expires_at ON idempotency_key (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS idempotency_key;
+-- +goose StatementEnd
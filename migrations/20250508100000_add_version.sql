@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Версия строки для оптимистичной блокировки: отдается клиенту как ETag и
+-- увеличивается при каждом изменении ПВЗ или приемки.
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE reception ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reception DROP COLUMN IF EXISTS version;
+ALTER TABLE pvz DROP COLUMN IF EXISTS version;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Владелец сохраненных ответов неизвестен: такие ключи нельзя повторять никому.
+DELETE FROM idempotency_key;
+
+ALTER TABLE idempotency_key ADD COLUMN IF NOT EXISTS subject varchar NOT NULL DEFAULT '';
+ALTER TABLE idempotency_key DROP CONSTRAINT IF EXISTS idempotency_key_pkey;
+ALTER TABLE idempotency_key ADD PRIMARY KEY (key, method, path, subject);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM idempotency_key;
+
+ALTER TABLE idempotency_key DROP CONSTRAINT IF EXISTS idempotency_key_pkey;
+ALTER TABLE idempotency_key ADD PRIMARY KEY (key, method, path);
+ALTER TABLE idempotency_key DROP COLUMN IF EXISTS subject;
+-- +goose StatementEnd
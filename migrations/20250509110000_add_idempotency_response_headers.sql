@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE idempotency_key ADD COLUMN IF NOT EXISTS response_headers JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE idempotency_key DROP COLUMN IF EXISTS response_headers;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Время последнего открытия приемки: от него автозакрытие отсчитывает max_open_minutes.
+ALTER TABLE reception ADD COLUMN IF NOT EXISTS opened_at TIMESTAMPTZ;
+UPDATE reception SET opened_at = reception_datetime WHERE opened_at IS NULL;
+ALTER TABLE reception ALTER COLUMN opened_at SET NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE reception DROP COLUMN IF EXISTS opened_at;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Отметка о построенной сводке за день: в daily_report у дня без ПВЗ нет ни одной строки.
+CREATE TABLE IF NOT EXISTS daily_report_run (
+    report_date DATE PRIMARY KEY,
+    built_at TIMESTAMPTZ NOT NULL
+);
+INSERT INTO daily_report_run (report_date, built_at)
+SELECT report_date, MAX(created_at) FROM daily_report GROUP BY report_date
+ON CONFLICT (report_date) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS daily_report_run;
+-- +goose StatementEnd